@@ -2,12 +2,16 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	_ "embed"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -17,10 +21,23 @@ import (
 
 	g "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/grpc"
 	pb "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/grpc/proto"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/idgen"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/notifier"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/redis"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/repository"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/revocation"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/application"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
 )
 
+// defaultLocations is the location catalog seeded when LOCATIONS_FILE is not
+// set.
+//
+//go:embed locations.json
+var defaultLocations []byte
+
 func main() {
 	err := godotenv.Load()
 	if err != nil {
@@ -39,39 +56,138 @@ func main() {
 		log.Fatalf("failed to ping DB: %v", err)
 	}
 
+	var cache ports.CachePort
+	var revocations ports.RevocationStorePort
 	redisAddr := os.Getenv("REDIS_ADDR")
-	log.Println(redisAddr)
-	redisUsername := os.Getenv("REDIS_USERNAME")
-	redisPassword := os.Getenv("REDIS_PASSWORD")
-	redisDB := 0 // Default DB
-	cache := redis.NewCache(redisAddr, redisUsername, redisPassword, redisDB, 5*time.Minute)
-	log.Println(cache)
-	if err := cache.Ping(context.Background()); err != nil {
-		log.Fatalf("failed to connect to Redis: %v", err)
+	if redisAddr != "" {
+		log.Println(redisAddr)
+		redisUsername := os.Getenv("REDIS_USERNAME")
+		redisPassword := os.Getenv("REDIS_PASSWORD")
+		redisDB := 0 // Default DB
+		redisCache := redis.NewCache(redisAddr, redisUsername, redisPassword, redisDB, 5*time.Minute)
+		if err := redisCache.Ping(context.Background()); err != nil {
+			log.Fatalf("failed to connect to Redis: %v", err)
+		}
+		cache = redisCache
+		revocations = revocation.NewCacheStore(redisCache)
+	} else {
+		// Without Redis, logouts only apply to this process and are lost on restart
+		log.Println("REDIS_ADDR not set, using in-memory token revocation store")
+		revocations = revocation.NewMemoryStore()
+	}
+
+	keySet, err := auth.LoadKeySetFromEnv()
+	if err != nil {
+		log.Fatalf("failed to load JWT keys: %v", err)
+	}
+	if keySet == nil {
+		log.Println("no JWT signing key configured, using an ephemeral key: tokens will not survive a restart")
+	} else {
+		auth.SetKeySet(keySet)
+		log.Printf("signing JWTs with key %s (%s)", keySet.SigningKey().ID, keySet.SigningKey().Algorithm)
+	}
+	if codeKey := os.Getenv("VERIFICATION_CODE_KEY"); codeKey != "" {
+		auth.SetCodeHashKey([]byte(codeKey))
+	} else {
+		log.Println("VERIFICATION_CODE_KEY not set, using an ephemeral key: verification codes will not survive a restart")
+	}
+
+	jwksAddr := os.Getenv("JWKS_ADDR")
+	if jwksAddr == "" {
+		jwksAddr = ":8080"
 	}
+	mux := http.NewServeMux()
+	mux.Handle("/.well-known/jwks.json", auth.JWKSHandler())
+	go func() {
+		fmt.Printf("JWKS endpoint listening on %s\n", jwksAddr)
+		if err := http.ListenAndServe(jwksAddr, mux); err != nil {
+			log.Fatalf("failed to serve JWKS: %v", err)
+		}
+	}()
 
-	initDB(db)
+	// ORDER_UNIQUE_MERCHANT_ORDER_ID rejects a second order with the same
+	// merchant_order_id for the same store
+	initDB(db, envBool("ORDER_UNIQUE_MERCHANT_ORDER_ID", false))
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("321dsaf"), bcrypt.DefaultCost)
 	if err != nil {
 		log.Printf("failed to hash default user password: %v", err)
 	} else {
-		_, err = db.Exec("INSERT INTO users (username, password) VALUES ($1, $2) ON CONFLICT (username) DO NOTHING",
+		_, err = db.Exec("INSERT INTO users (username, password, email_verified) VALUES ($1, $2, TRUE) ON CONFLICT (username) DO NOTHING",
 			"01901901901@mailinator.com", string(hashedPassword))
 		if err != nil {
 			log.Printf("failed to insert default user: %v", err)
 		}
 	}
 
+	// ADMIN_USERNAME bootstraps the first administrator, who can then manage roles over the API
+	if adminUsername := os.Getenv("ADMIN_USERNAME"); adminUsername != "" {
+		_, err = db.Exec("UPDATE users SET roles = array_append(roles, 'admin') WHERE username = $1 AND NOT 'admin' = ANY(roles)", adminUsername)
+		if err != nil {
+			log.Printf("failed to grant admin role: %v", err)
+		}
+	}
+
 	repo := repository.NewPostgresRepository(db)
-	srv := g.NewServer(repo, cache)
+	lockout := application.DefaultLockoutConfig()
+	lockout.MaxAttempts = envInt64("LOGIN_MAX_ATTEMPTS", lockout.MaxAttempts)
+	lockout.MaxAttemptsPerIP = envInt64("LOGIN_MAX_ATTEMPTS_PER_IP", lockout.MaxAttemptsPerIP)
+	lockout.BaseDelay = envDuration("LOGIN_BACKOFF_BASE", lockout.BaseDelay)
+	lockout.LockoutDuration = envDuration("LOGIN_LOCKOUT_DURATION", lockout.LockoutDuration)
+	lockout.Window = envDuration("LOGIN_ATTEMPT_WINDOW", lockout.Window)
+	if cache == nil {
+		log.Println("login throttling disabled: it requires Redis")
+	}
+
+	// NOTIFIER_FILE writes password reset and email verification messages to a file
+	// instead of the log; neither delivers real email.
+	var notify ports.NotifierPort = notifier.NewLogNotifier()
+	if path := os.Getenv("NOTIFIER_FILE"); path != "" {
+		notify = notifier.NewFileNotifier(path)
+	}
+
+	authService := application.NewAuthService(repo, revocations, cache, notify, lockout)
+	consignmentIDFormat := os.Getenv("CONSIGNMENT_ID_FORMAT")
+	if consignmentIDFormat == "" {
+		consignmentIDFormat = domain.DefaultConsignmentIDFormat
+	}
+	ids, err := idgen.NewSequenceGenerator(db, consignmentIDFormat)
+	if err != nil {
+		log.Fatalf("invalid CONSIGNMENT_ID_FORMAT: %v", err)
+	}
+	pricingService := application.NewPricingService(repo)
+	if err := pricingService.EnsureRateCard(context.Background()); err != nil {
+		log.Fatalf("failed to create default rate card: %v", err)
+	}
+	// LOCATIONS_FILE replaces the built-in catalog of cities, zones and areas
+	locationService := application.NewLocationService(repo)
+	locations := defaultLocations
+	if path := os.Getenv("LOCATIONS_FILE"); path != "" {
+		if locations, err = os.ReadFile(path); err != nil {
+			log.Fatalf("failed to read LOCATIONS_FILE: %v", err)
+		}
+	}
+	if err := locationService.Seed(context.Background(), bytes.NewReader(locations)); err != nil {
+		log.Fatalf("failed to seed locations: %v", err)
+	}
+	orderService := application.NewOrderService(repo, cache, ids, pricingService, locationService)
+	orderService.SetIdempotencyRetention(envDuration("IDEMPOTENCY_KEY_RETENTION", application.DefaultIdempotencyRetention))
+	go orderService.RunIdempotencyKeyPurge(context.Background(), time.Hour)
+
+	// ORDER_AUTO_ARCHIVE_AFTER=0 turns the auto-archive job off
+	if archiveAfter := envDuration("ORDER_AUTO_ARCHIVE_AFTER", 30*24*time.Hour); archiveAfter > 0 {
+		go orderService.RunAutoArchive(context.Background(), archiveAfter, envDuration("ORDER_AUTO_ARCHIVE_INTERVAL", time.Hour))
+	}
+	apiKeyService := application.NewAPIKeyService(repo)
+	storeService := application.NewStoreService(repo)
+	srv := g.NewServer(authService, orderService, apiKeyService, pricingService, storeService, locationService)
 
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(g.AuthInterceptor))
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(srv.AuthInterceptor), grpc.StreamInterceptor(srv.StreamAuthInterceptor))
 	pb.RegisterOrderServiceServer(grpcServer, srv)
 
 	fmt.Println("gRPC server listening on :50051")
@@ -80,13 +196,53 @@ func main() {
 	}
 }
 
-func initDB(db *sql.DB) {
+func envInt64(name string, def int64) int64 {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", name, err)
+	}
+	return n
+}
+
+func envBool(name string, def bool) bool {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", name, err)
+	}
+	return b
+}
+
+func envDuration(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", name, err)
+	}
+	return d
+}
+
+func initDB(db *sql.DB, uniqueMerchantOrderIDs bool) {
 	queries := []string{
 		`CREATE TABLE IF NOT EXISTS users (
 			id SERIAL PRIMARY KEY,
 			username VARCHAR(255) UNIQUE NOT NULL,
 			password VARCHAR(255) NOT NULL
 		)`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{merchant}'`,
+		// Accounts created before email verification existed count as verified
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT TRUE`,
+		`ALTER TABLE users ALTER COLUMN email_verified SET DEFAULT FALSE`,
 		`CREATE TABLE IF NOT EXISTS orders (
 			consignment_id VARCHAR(255) PRIMARY KEY,
 			created_at TIMESTAMP NOT NULL,
@@ -95,21 +251,21 @@ func initDB(db *sql.DB) {
 			recipient_name VARCHAR(255) NOT NULL,
 			recipient_address TEXT NOT NULL,
 			recipient_phone VARCHAR(20) NOT NULL,
-			order_amount FLOAT NOT NULL,
-			total_fee FLOAT NOT NULL,
+			order_amount BIGINT NOT NULL,
+			total_fee BIGINT NOT NULL,
 			instruction TEXT,
 			order_type_id BIGINT NOT NULL,
-			cod_fee FLOAT NOT NULL,
-			promo_discount FLOAT NOT NULL,
-			discount FLOAT NOT NULL,
-			delivery_fee FLOAT NOT NULL,
+			cod_fee BIGINT NOT NULL,
+			promo_discount BIGINT NOT NULL,
+			discount BIGINT NOT NULL,
+			delivery_fee BIGINT NOT NULL,
 			status VARCHAR(50) NOT NULL,
 			order_type VARCHAR(50) NOT NULL,
 			item_type BIGINT NOT NULL,
 			store_name VARCHAR(255),
 			store_contact_phone VARCHAR(20),
-			cod_amount FLOAT NOT NULL,
-			delivery_charge FLOAT NOT NULL,
+			cod_amount BIGINT NOT NULL,
+			delivery_charge BIGINT NOT NULL,
 			user_id BIGINT REFERENCES users(id),
 			store_id BIGINT NOT NULL,
 			recipient_city BIGINT NOT NULL,
@@ -118,9 +274,201 @@ func initDB(db *sql.DB) {
 			delivery_type BIGINT NOT NULL,
 			item_quantity BIGINT NOT NULL,
 			item_weight FLOAT NOT NULL,
-			amount_to_collect FLOAT NOT NULL
+			amount_to_collect BIGINT NOT NULL
+		)`,
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP`,
+		`CREATE INDEX IF NOT EXISTS idx_orders_user_id_created_at ON orders (user_id, created_at)`,
+		`CREATE SEQUENCE IF NOT EXISTS consignment_id_seq`,
+		`CREATE TABLE IF NOT EXISTS rate_cards (
+			version SERIAL PRIMARY KEY,
+			effective_from TIMESTAMP NOT NULL,
+			cod_percent FLOAT NOT NULL,
+			min_cod_fee BIGINT NOT NULL DEFAULT 0,
+			min_delivery_fee BIGINT NOT NULL DEFAULT 0,
+			created_by BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS rate_card_rates (
+			id SERIAL PRIMARY KEY,
+			rate_card_version INT NOT NULL REFERENCES rate_cards(version),
+			city BIGINT NOT NULL DEFAULT 0,
+			zone BIGINT NOT NULL DEFAULT 0,
+			area BIGINT NOT NULL DEFAULT 0,
+			delivery_type BIGINT NOT NULL DEFAULT 0,
+			item_type BIGINT NOT NULL DEFAULT 0,
+			base_fee BIGINT NOT NULL,
+			weight_bands JSONB NOT NULL DEFAULT '[]',
+			extra_kg_fee BIGINT NOT NULL DEFAULT 0
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_rate_card_rates_version ON rate_card_rates (rate_card_version)`,
+		// Orders priced before rate cards existed have version 0
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS rate_card_version BIGINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS discount_percent FLOAT NOT NULL DEFAULT 0`,
+		`CREATE TABLE IF NOT EXISTS promotions (
+			id SERIAL PRIMARY KEY,
+			code VARCHAR(32) UNIQUE NOT NULL,
+			kind VARCHAR(16) NOT NULL,
+			value FLOAT NOT NULL,
+			amount BIGINT NOT NULL DEFAULT 0,
+			max_discount BIGINT NOT NULL DEFAULT 0,
+			starts_at TIMESTAMP NOT NULL,
+			ends_at TIMESTAMP,
+			max_redemptions BIGINT NOT NULL DEFAULT 0,
+			max_per_merchant BIGINT NOT NULL DEFAULT 0,
+			merchant_id BIGINT NOT NULL DEFAULT 0,
+			cities INT8[] NOT NULL DEFAULT '{}',
+			delivery_types INT8[] NOT NULL DEFAULT '{}',
+			redemptions BIGINT NOT NULL DEFAULT 0,
+			created_by BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS promotion_redemptions (
+			id SERIAL PRIMARY KEY,
+			promotion_id INT NOT NULL REFERENCES promotions(id),
+			user_id BIGINT NOT NULL,
+			consignment_id VARCHAR(255) NOT NULL,
+			discount BIGINT NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_promotion_redemptions_promotion_user ON promotion_redemptions (promotion_id, user_id)`,
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS promo_code VARCHAR(32) NOT NULL DEFAULT ''`,
+		`CREATE TABLE IF NOT EXISTS stores (
+			id SERIAL PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			name VARCHAR(255) NOT NULL,
+			contact_phone VARCHAR(20) NOT NULL,
+			pickup_address TEXT NOT NULL,
+			default_delivery_type BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_stores_user_id ON stores (user_id)`,
+		`CREATE TABLE IF NOT EXISTS cities (
+			id BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS zones (
+			id BIGINT PRIMARY KEY,
+			city_id BIGINT NOT NULL REFERENCES cities(id),
+			name VARCHAR(255) NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_zones_city_id ON zones (city_id)`,
+		`CREATE TABLE IF NOT EXISTS areas (
+			id BIGINT PRIMARY KEY,
+			zone_id BIGINT NOT NULL REFERENCES zones(id),
+			name VARCHAR(255) NOT NULL,
+			serviceable BOOLEAN NOT NULL DEFAULT TRUE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_areas_zone_id ON areas (zone_id)`,
+		`CREATE TABLE IF NOT EXISTS idempotency_keys (
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			idempotency_key VARCHAR(255) NOT NULL,
+			request_hash VARCHAR(64) NOT NULL,
+			response BYTEA NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, idempotency_key)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys (created_at)`,
+		`CREATE TABLE IF NOT EXISTS order_status_history (
+			id SERIAL PRIMARY KEY,
+			consignment_id VARCHAR(255) NOT NULL REFERENCES orders(consignment_id),
+			from_status VARCHAR(50) NOT NULL,
+			to_status VARCHAR(50) NOT NULL,
+			actor_id BIGINT NOT NULL REFERENCES users(id),
+			reason TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_order_status_history_consignment_id ON order_status_history (consignment_id)`,
+		`ALTER TABLE order_status_history ADD COLUMN IF NOT EXISTS changes JSONB NOT NULL DEFAULT 'null'`,
+		`CREATE TABLE IF NOT EXISTS refresh_tokens (
+			id SERIAL PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			family_id VARCHAR(64) NOT NULL,
+			token_hash VARCHAR(64) UNIQUE NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP,
+			revoked_at TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id)`,
+		`CREATE TABLE IF NOT EXISTS sessions (
+			id VARCHAR(64) PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			device VARCHAR(255) NOT NULL DEFAULT '',
+			ip VARCHAR(64) NOT NULL DEFAULT '',
+			user_agent VARCHAR(512) NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL,
+			last_seen_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id)`,
+		`CREATE TABLE IF NOT EXISTS two_factor (
+			user_id BIGINT PRIMARY KEY REFERENCES users(id),
+			secret VARCHAR(64) NOT NULL,
+			last_step BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			enabled_at TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS recovery_codes (
+			id SERIAL PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			code_hash VARCHAR(64) NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_recovery_codes_user_id ON recovery_codes (user_id)`,
+		`CREATE TABLE IF NOT EXISTS password_resets (
+			id SERIAL PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			token_hash VARCHAR(64) UNIQUE NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_password_resets_user_id ON password_resets (user_id)`,
+		`CREATE TABLE IF NOT EXISTS email_verifications (
+			id SERIAL PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			code_hash VARCHAR(64) NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_email_verifications_user_id ON email_verifications (user_id)`,
+		`CREATE TABLE IF NOT EXISTS api_keys (
+			id SERIAL PRIMARY KEY,
+			user_id BIGINT NOT NULL REFERENCES users(id),
+			store_id BIGINT NOT NULL DEFAULT 0,
+			name VARCHAR(255) NOT NULL,
+			prefix VARCHAR(32) UNIQUE NOT NULL,
+			key_hash VARCHAR(64) NOT NULL,
+			methods TEXT[] NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			last_used_at TIMESTAMP,
+			revoked_at TIMESTAMP
 		)`,
+		`CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id)`,
+	}
+	// Merchants without stores all book their orders with store_id 0, so the
+	// index includes user_id to keep their merchant order IDs apart. Creating
+	// it fails while duplicates exist; they have to be resolved first.
+	if uniqueMerchantOrderIDs {
+		queries = append(queries, `CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_store_merchant_order_id
+			ON orders (user_id, store_id, merchant_order_id) WHERE merchant_order_id <> ''`)
+	} else {
+		queries = append(queries, `DROP INDEX IF EXISTS idx_orders_store_merchant_order_id`)
 	}
+	// Amounts are stored as whole numbers of poisha. They were floats of taka
+	// before, and flat promotions kept their amount in value.
+	for _, c := range moneyColumns {
+		queries = append(queries, moneyColumnMigration(c[0], c[1]))
+	}
+	queries = append(queries,
+		`ALTER TABLE promotions ADD COLUMN IF NOT EXISTS amount BIGINT NOT NULL DEFAULT 0`,
+		`UPDATE promotions SET amount = ROUND(value * 100), value = 0 WHERE kind = 'flat' AND amount = 0 AND value <> 0`,
+		`ALTER TABLE rate_card_rates ADD COLUMN IF NOT EXISTS overweight_fee BIGINT NOT NULL DEFAULT 0`,
+	)
 	for _, q := range queries {
 		_, err := db.Exec(q)
 		if err != nil {
@@ -128,3 +476,33 @@ func initDB(db *sql.DB) {
 		}
 	}
 }
+
+// moneyColumns are the table and column names of the stored amounts.
+var moneyColumns = [][2]string{
+	{"orders", "order_amount"},
+	{"orders", "total_fee"},
+	{"orders", "cod_fee"},
+	{"orders", "promo_discount"},
+	{"orders", "discount"},
+	{"orders", "delivery_fee"},
+	{"orders", "cod_amount"},
+	{"orders", "delivery_charge"},
+	{"orders", "amount_to_collect"},
+	{"rate_cards", "min_cod_fee"},
+	{"rate_cards", "min_delivery_fee"},
+	{"rate_card_rates", "base_fee"},
+	{"rate_card_rates", "extra_kg_fee"},
+	{"promotions", "max_discount"},
+	{"promotion_redemptions", "discount"},
+}
+
+// moneyColumnMigration converts a column holding a float amount of taka to
+// a whole number of poisha. It does nothing once the column is converted, so
+// it can run on every start.
+func moneyColumnMigration(table, column string) string {
+	return fmt.Sprintf(`DO $$ BEGIN
+		IF (SELECT data_type FROM information_schema.columns WHERE table_name = '%[1]s' AND column_name = '%[2]s') = 'double precision' THEN
+			ALTER TABLE %[1]s ALTER COLUMN %[2]s TYPE BIGINT USING ROUND(%[2]s * 100);
+		END IF;
+	END $$`, table, column)
+}
@@ -0,0 +1,93 @@
+// internal/adapters/grpc/policy.go
+package grpc
+
+import (
+	pb "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/grpc/proto"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+)
+
+// methodPolicy describes who may call an RPC. Public methods skip
+// authentication; otherwise the caller needs at least one of roles, or just a
+// valid token if roles is empty. Only methods marked apiKey can be called with
+// an API key instead of a JWT, and methods marked verified also require the
+// caller to have verified their email address.
+type methodPolicy struct {
+	public   bool
+	apiKey   bool
+	verified bool
+	roles    []string
+}
+
+// methodPolicies is enforced by AuthInterceptor and StreamAuthInterceptor.
+// Methods missing from the table are denied, so every new RPC has to be added
+// here explicitly.
+var methodPolicies = map[string]methodPolicy{
+	pb.OrderService_Signup_FullMethodName:                  {public: true},
+	pb.OrderService_Login_FullMethodName:                   {public: true},
+	pb.OrderService_RefreshToken_FullMethodName:            {public: true},
+	pb.OrderService_RequestPasswordReset_FullMethodName:    {public: true},
+	pb.OrderService_ResetPassword_FullMethodName:           {public: true},
+	pb.OrderService_VerifyTwoFactor_FullMethodName:         {public: true},
+	pb.OrderService_Logout_FullMethodName:                  {},
+	pb.OrderService_ChangePassword_FullMethodName:          {},
+	pb.OrderService_VerifyEmail_FullMethodName:             {},
+	pb.OrderService_SendVerificationCode_FullMethodName:    {},
+	pb.OrderService_ListSessions_FullMethodName:            {},
+	pb.OrderService_RevokeSession_FullMethodName:           {},
+	pb.OrderService_EnrollTwoFactor_FullMethodName:         {},
+	pb.OrderService_ConfirmTwoFactor_FullMethodName:        {},
+	pb.OrderService_DisableTwoFactor_FullMethodName:        {},
+	pb.OrderService_RegenerateRecoveryCodes_FullMethodName: {},
+
+	pb.OrderService_CreateOrder_FullMethodName:      {apiKey: true, verified: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_BulkCreateOrders_FullMethodName: {apiKey: true, verified: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_ImportOrdersCsv_FullMethodName:  {apiKey: true, verified: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_QuoteDeliveryFee_FullMethodName: {apiKey: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_ListOrders_FullMethodName:       {apiKey: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_GetOrder_FullMethodName:         {apiKey: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_CancelOrder_FullMethodName:      {apiKey: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_UpdateOrder_FullMethodName:      {apiKey: true, verified: true, roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_ArchiveOrders_FullMethodName:    {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_UnarchiveOrders_FullMethodName:  {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+
+	pb.OrderService_UpdateOrderStatus_FullMethodName: {roles: []string{domain.RoleOperator, domain.RoleAdmin}},
+
+	pb.OrderService_CreateApiKey_FullMethodName: {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_ListApiKeys_FullMethodName:  {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_RevokeApiKey_FullMethodName: {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+
+	pb.OrderService_CreateStore_FullMethodName: {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_UpdateStore_FullMethodName: {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+	pb.OrderService_ListStores_FullMethodName:  {roles: []string{domain.RoleMerchant, domain.RoleAdmin}},
+
+	pb.OrderService_ListCities_FullMethodName: {apiKey: true},
+	pb.OrderService_ListZones_FullMethodName:  {apiKey: true},
+	pb.OrderService_ListAreas_FullMethodName:  {apiKey: true},
+
+	pb.OrderService_UpdateUserRoles_FullMethodName:     {roles: []string{domain.RoleAdmin}},
+	pb.OrderService_UnlockAccount_FullMethodName:       {roles: []string{domain.RoleAdmin}},
+	pb.OrderService_CreateRateCard_FullMethodName:      {roles: []string{domain.RoleAdmin}},
+	pb.OrderService_ListRateCards_FullMethodName:       {roles: []string{domain.RoleAdmin}},
+	pb.OrderService_CreatePromotion_FullMethodName:     {roles: []string{domain.RoleAdmin}},
+	pb.OrderService_ListPromotions_FullMethodName:      {roles: []string{domain.RoleAdmin}},
+	pb.OrderService_SetMerchantDiscount_FullMethodName: {roles: []string{domain.RoleAdmin}},
+}
+
+// fullMethodName turns an RPC name such as "CreateOrder" into its gRPC path.
+func fullMethodName(method string) string {
+	return "/" + pb.OrderService_ServiceDesc.ServiceName + "/" + method
+}
+
+func (p methodPolicy) allows(roles []string) bool {
+	if len(p.roles) == 0 {
+		return true
+	}
+	for _, want := range p.roles {
+		for _, have := range roles {
+			if want == have {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+// internal/adapters/grpc/policy_test.go
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/grpc/proto"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/revocation"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/application"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+func TestAuthInterceptor_Policies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, EmailVerified: true}, nil).AnyTimes()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(3)).Return(&domain.User{ID: 3}, nil).AnyTimes()
+	srv := NewServer(application.NewAuthService(mockRepo, revocation.NewMemoryStore(), nil, nil, application.DefaultLockoutConfig()), nil, nil, nil, nil, nil)
+
+	merchantToken, _ := auth.GenerateToken("merchant@example.com", 1, []string{domain.RoleMerchant}, "")
+	adminToken, _ := auth.GenerateToken("admin@example.com", 2, []string{domain.RoleAdmin}, "")
+	unverifiedToken, _ := auth.GenerateToken("new@example.com", 3, []string{domain.RoleMerchant}, "")
+
+	tests := []struct {
+		name     string
+		method   string
+		token    string
+		apiKey   string
+		wantCode codes.Code
+	}{
+		{name: "Public method without token", method: pb.OrderService_Login_FullMethodName, wantCode: codes.OK},
+		{name: "Missing token", method: pb.OrderService_ListOrders_FullMethodName, wantCode: codes.Unauthenticated},
+		{name: "Merchant lists orders", method: pb.OrderService_ListOrders_FullMethodName, token: merchantToken, wantCode: codes.OK},
+		{name: "Merchant calls admin RPC", method: pb.OrderService_UpdateUserRoles_FullMethodName, token: merchantToken, wantCode: codes.PermissionDenied},
+		{name: "Admin calls admin RPC", method: pb.OrderService_UpdateUserRoles_FullMethodName, token: adminToken, wantCode: codes.OK},
+		{name: "Verified merchant creates order", method: pb.OrderService_CreateOrder_FullMethodName, token: merchantToken, wantCode: codes.OK},
+		{name: "Unverified merchant creates order", method: pb.OrderService_CreateOrder_FullMethodName, token: unverifiedToken, wantCode: codes.PermissionDenied},
+		{name: "Unverified merchant lists orders", method: pb.OrderService_ListOrders_FullMethodName, token: unverifiedToken, wantCode: codes.OK},
+		{name: "Unknown method", method: "/order.OrderService/DropTables", token: adminToken, wantCode: codes.PermissionDenied},
+		{name: "API key on JWT-only method", method: pb.OrderService_CreateApiKey_FullMethodName, apiKey: "gek_abc.secret", wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+			}
+			if tt.apiKey != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", tt.apiKey))
+			}
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := srv.AuthInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("AuthInterceptor() code = %v, want %v", code, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("AuthInterceptor() handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
@@ -23,6 +23,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Money is an exact amount as a whole number of minor units, poisha for BDT.
+// Amounts are also sent as double fields for older clients; when a request
+// sets both, the Money field wins.
+type Money struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	MinorUnits int64                  `protobuf:"varint,1,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	// ISO 4217 code. Only "BDT" is supported; empty means BDT.
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetMinorUnits() int64 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type SignupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -33,7 +89,7 @@ type SignupRequest struct {
 
 func (x *SignupRequest) Reset() {
 	*x = SignupRequest{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[0]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +101,7 @@ func (x *SignupRequest) String() string {
 func (*SignupRequest) ProtoMessage() {}
 
 func (x *SignupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[0]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +114,7 @@ func (x *SignupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignupRequest.ProtoReflect.Descriptor instead.
 func (*SignupRequest) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{0}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *SignupRequest) GetUsername() string {
@@ -86,7 +142,7 @@ type SignupResponse struct {
 
 func (x *SignupResponse) Reset() {
 	*x = SignupResponse{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[1]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +154,7 @@ func (x *SignupResponse) String() string {
 func (*SignupResponse) ProtoMessage() {}
 
 func (x *SignupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[1]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +167,7 @@ func (x *SignupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignupResponse.ProtoReflect.Descriptor instead.
 func (*SignupResponse) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{1}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SignupResponse) GetMessage() string {
@@ -145,7 +201,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[2]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -157,7 +213,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[2]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +226,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{2}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LoginRequest) GetUsername() string {
@@ -188,21 +244,22 @@ func (x *LoginRequest) GetPassword() string {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TokenType     string                 `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	AccessToken   string                 `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
-	Code          int32                  `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TokenType      string                 `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	ExpiresIn      int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	AccessToken    string                 `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken   string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Type           string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Code           int32                  `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	ChallengeToken string                 `protobuf:"bytes,8,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[3]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +271,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[3]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +284,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{3}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoginResponse) GetTokenType() string {
@@ -279,30 +336,177 @@ func (x *LoginResponse) GetCode() int32 {
 	return 0
 }
 
+func (x *LoginResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenType     string                 `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefreshTokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
 type CreateOrderRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	StoreId            int64                  `protobuf:"varint,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	MerchantOrderId    string                 `protobuf:"bytes,2,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
-	RecipientName      string                 `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
-	RecipientPhone     string                 `protobuf:"bytes,4,opt,name=recipient_phone,json=recipientPhone,proto3" json:"recipient_phone,omitempty"`
-	RecipientAddress   string                 `protobuf:"bytes,5,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
-	RecipientCity      int64                  `protobuf:"varint,6,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
-	RecipientZone      int64                  `protobuf:"varint,7,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
-	RecipientArea      int64                  `protobuf:"varint,8,opt,name=recipient_area,json=recipientArea,proto3" json:"recipient_area,omitempty"`
-	DeliveryType       int64                  `protobuf:"varint,9,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
-	ItemType           int64                  `protobuf:"varint,10,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
-	SpecialInstruction string                 `protobuf:"bytes,11,opt,name=special_instruction,json=specialInstruction,proto3" json:"special_instruction,omitempty"`
-	ItemQuantity       int64                  `protobuf:"varint,12,opt,name=item_quantity,json=itemQuantity,proto3" json:"item_quantity,omitempty"`
-	ItemWeight         float64                `protobuf:"fixed64,13,opt,name=item_weight,json=itemWeight,proto3" json:"item_weight,omitempty"`
-	AmountToCollect    float64                `protobuf:"fixed64,14,opt,name=amount_to_collect,json=amountToCollect,proto3" json:"amount_to_collect,omitempty"`
-	ItemDescription    string                 `protobuf:"bytes,15,opt,name=item_description,json=itemDescription,proto3" json:"item_description,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of the caller's stores, see CreateStore; 0 books the order for their
+	// oldest store.
+	StoreId              int64   `protobuf:"varint,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	MerchantOrderId      string  `protobuf:"bytes,2,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
+	RecipientName        string  `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	RecipientPhone       string  `protobuf:"bytes,4,opt,name=recipient_phone,json=recipientPhone,proto3" json:"recipient_phone,omitempty"`
+	RecipientAddress     string  `protobuf:"bytes,5,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	RecipientCity        int64   `protobuf:"varint,6,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
+	RecipientZone        int64   `protobuf:"varint,7,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
+	RecipientArea        int64   `protobuf:"varint,8,opt,name=recipient_area,json=recipientArea,proto3" json:"recipient_area,omitempty"`
+	DeliveryType         int64   `protobuf:"varint,9,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
+	ItemType             int64   `protobuf:"varint,10,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	SpecialInstruction   string  `protobuf:"bytes,11,opt,name=special_instruction,json=specialInstruction,proto3" json:"special_instruction,omitempty"`
+	ItemQuantity         int64   `protobuf:"varint,12,opt,name=item_quantity,json=itemQuantity,proto3" json:"item_quantity,omitempty"`
+	ItemWeight           float64 `protobuf:"fixed64,13,opt,name=item_weight,json=itemWeight,proto3" json:"item_weight,omitempty"`
+	AmountToCollect      float64 `protobuf:"fixed64,14,opt,name=amount_to_collect,json=amountToCollect,proto3" json:"amount_to_collect,omitempty"`
+	ItemDescription      string  `protobuf:"bytes,15,opt,name=item_description,json=itemDescription,proto3" json:"item_description,omitempty"`
+	PromoCode            string  `protobuf:"bytes,16,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	AmountToCollectMoney *Money  `protobuf:"bytes,17,opt,name=amount_to_collect_money,json=amountToCollectMoney,proto3" json:"amount_to_collect_money,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[4]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -314,7 +518,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[4]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +531,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{4}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateOrderRequest) GetStoreId() int64 {
@@ -435,6 +639,20 @@ func (x *CreateOrderRequest) GetItemDescription() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetAmountToCollectMoney() *Money {
+	if x != nil {
+		return x.AmountToCollectMoney
+	}
+	return nil
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -447,7 +665,7 @@ type CreateOrderResponse struct {
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[5]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +677,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[5]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +690,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{5}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateOrderResponse) GetMessage() string {
@@ -504,18 +722,19 @@ func (x *CreateOrderResponse) GetData() *OrderData {
 }
 
 type OrderData struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ConsignmentId   string                 `protobuf:"bytes,1,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
-	MerchantOrderId string                 `protobuf:"bytes,2,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
-	OrderStatus     string                 `protobuf:"bytes,3,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
-	DeliveryFee     float64                `protobuf:"fixed64,4,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConsignmentId    string                 `protobuf:"bytes,1,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
+	MerchantOrderId  string                 `protobuf:"bytes,2,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
+	OrderStatus      string                 `protobuf:"bytes,3,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	DeliveryFee      float64                `protobuf:"fixed64,4,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	DeliveryFeeMoney *Money                 `protobuf:"bytes,5,opt,name=delivery_fee_money,json=deliveryFeeMoney,proto3" json:"delivery_fee_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OrderData) Reset() {
 	*x = OrderData{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[6]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +746,7 @@ func (x *OrderData) String() string {
 func (*OrderData) ProtoMessage() {}
 
 func (x *OrderData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[6]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +759,7 @@ func (x *OrderData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderData.ProtoReflect.Descriptor instead.
 func (*OrderData) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{6}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OrderData) GetConsignmentId() string {
@@ -571,19 +790,50 @@ func (x *OrderData) GetDeliveryFee() float64 {
 	return 0
 }
 
+func (x *OrderData) GetDeliveryFeeMoney() *Money {
+	if x != nil {
+		return x.DeliveryFeeMoney
+	}
+	return nil
+}
+
 type ListOrdersRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TransferStatus int64                  `protobuf:"varint,1,opt,name=transfer_status,json=transferStatus,proto3" json:"transfer_status,omitempty"`
-	Archive        int64                  `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
-	Limit          int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page           int64                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 lists every order, 1 orders still in progress, 2 orders in a final
+	// status (Delivered, Returned or Cancelled).
+	TransferStatus int64 `protobuf:"varint,1,opt,name=transfer_status,json=transferStatus,proto3" json:"transfer_status,omitempty"`
+	// 0 lists orders that are not archived, 1 only archived orders.
+	Archive int64    `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	Limit   int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page    int64    `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Status  []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	// RFC 3339 timestamps or YYYY-MM-DD dates. created_from is inclusive,
+	// created_to is exclusive, or covers the whole day when it is a date.
+	CreatedFrom     string `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo       string `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	RecipientCity   int64  `protobuf:"varint,8,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
+	RecipientZone   int64  `protobuf:"varint,9,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
+	StoreId         int64  `protobuf:"varint,10,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	MerchantOrderId string `protobuf:"bytes,11,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
+	// Matches part of the recipient name or phone number.
+	Search string `protobuf:"bytes,12,opt,name=search,proto3" json:"search,omitempty"`
+	// created_desc (default), created_asc, amount_desc or amount_asc.
+	Sort string `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Cursor pagination is used when cursor or page_token is set. Pass the
+	// next_page_token of the previous response to get the following page.
+	PageToken string `protobuf:"bytes,14,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Counts the matching orders in cursor pagination, where total is -1
+	// otherwise.
+	IncludeTotal bool `protobuf:"varint,15,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	// Starts cursor pagination; page is ignored.
+	Cursor        bool `protobuf:"varint,16,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListOrdersRequest) Reset() {
 	*x = ListOrdersRequest{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[7]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +845,7 @@ func (x *ListOrdersRequest) String() string {
 func (*ListOrdersRequest) ProtoMessage() {}
 
 func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[7]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +858,7 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{7}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListOrdersRequest) GetTransferStatus() int64 {
@@ -639,21 +889,105 @@ func (x *ListOrdersRequest) GetPage() int64 {
 	return 0
 }
 
-type ListOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
-	Data          *OrdersData            `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListOrdersRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListOrdersRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetRecipientCity() int64 {
+	if x != nil {
+		return x.RecipientCity
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetRecipientZone() int64 {
+	if x != nil {
+		return x.RecipientZone
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetMerchantOrderId() string {
+	if x != nil {
+		return x.MerchantOrderId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetCursor() bool {
+	if x != nil {
+		return x.Cursor
+	}
+	return false
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *OrdersData            `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListOrdersResponse) String() string {
@@ -663,7 +997,7 @@ func (x *ListOrdersResponse) String() string {
 func (*ListOrdersResponse) ProtoMessage() {}
 
 func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[8]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +1010,7 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
 func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{8}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListOrdersResponse) GetMessage() string {
@@ -715,13 +1049,14 @@ type OrdersData struct {
 	PerPage       int64                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
 	TotalInPage   int64                  `protobuf:"varint,5,opt,name=total_in_page,json=totalInPage,proto3" json:"total_in_page,omitempty"`
 	LastPage      int64                  `protobuf:"varint,6,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrdersData) Reset() {
 	*x = OrdersData{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[9]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -733,7 +1068,7 @@ func (x *OrdersData) String() string {
 func (*OrdersData) ProtoMessage() {}
 
 func (x *OrdersData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[9]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +1081,7 @@ func (x *OrdersData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrdersData.ProtoReflect.Descriptor instead.
 func (*OrdersData) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{9}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OrdersData) GetOrders() []*Order {
@@ -791,45 +1126,64 @@ func (x *OrdersData) GetLastPage() int64 {
 	return 0
 }
 
+func (x *OrdersData) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Order struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	OrderConsignmentId string                 `protobuf:"bytes,1,opt,name=order_consignment_id,json=orderConsignmentId,proto3" json:"order_consignment_id,omitempty"`
-	OrderCreatedAt     string                 `protobuf:"bytes,2,opt,name=order_created_at,json=orderCreatedAt,proto3" json:"order_created_at,omitempty"`
-	OrderDescription   string                 `protobuf:"bytes,3,opt,name=order_description,json=orderDescription,proto3" json:"order_description,omitempty"`
-	MerchantOrderId    string                 `protobuf:"bytes,4,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
-	RecipientName      string                 `protobuf:"bytes,5,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
-	RecipientAddress   string                 `protobuf:"bytes,6,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
-	RecipientPhone     string                 `protobuf:"bytes,7,opt,name=recipient_phone,json=recipientPhone,proto3" json:"recipient_phone,omitempty"`
-	OrderAmount        float64                `protobuf:"fixed64,8,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
-	TotalFee           float64                `protobuf:"fixed64,9,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
-	Instruction        string                 `protobuf:"bytes,10,opt,name=instruction,proto3" json:"instruction,omitempty"`
-	OrderTypeId        int64                  `protobuf:"varint,11,opt,name=order_type_id,json=orderTypeId,proto3" json:"order_type_id,omitempty"`
-	CodFee             float64                `protobuf:"fixed64,12,opt,name=cod_fee,json=codFee,proto3" json:"cod_fee,omitempty"`
-	PromoDiscount      float64                `protobuf:"fixed64,13,opt,name=promo_discount,json=promoDiscount,proto3" json:"promo_discount,omitempty"`
-	Discount           float64                `protobuf:"fixed64,14,opt,name=discount,proto3" json:"discount,omitempty"`
-	DeliveryFee        float64                `protobuf:"fixed64,15,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
-	OrderStatus        string                 `protobuf:"bytes,16,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
-	OrderType          string                 `protobuf:"bytes,17,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
-	ItemType           int64                  `protobuf:"varint,18,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
-	StoreName          string                 `protobuf:"bytes,19,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
-	StoreContactPhone  string                 `protobuf:"bytes,20,opt,name=store_contact_phone,json=storeContactPhone,proto3" json:"store_contact_phone,omitempty"`
-	CodAmount          float64                `protobuf:"fixed64,21,opt,name=cod_amount,json=codAmount,proto3" json:"cod_amount,omitempty"`
-	DeliveryCharge     float64                `protobuf:"fixed64,22,opt,name=delivery_charge,json=deliveryCharge,proto3" json:"delivery_charge,omitempty"`
-	StoreId            int64                  `protobuf:"varint,23,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	RecipientCity      int64                  `protobuf:"varint,24,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
-	RecipientZone      int64                  `protobuf:"varint,25,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
-	RecipientArea      int64                  `protobuf:"varint,26,opt,name=recipient_area,json=recipientArea,proto3" json:"recipient_area,omitempty"`
-	DeliveryType       int64                  `protobuf:"varint,27,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
-	ItemQuantity       int64                  `protobuf:"varint,28,opt,name=item_quantity,json=itemQuantity,proto3" json:"item_quantity,omitempty"`
-	ItemWeight         float64                `protobuf:"fixed64,29,opt,name=item_weight,json=itemWeight,proto3" json:"item_weight,omitempty"`
-	AmountToCollect    float64                `protobuf:"fixed64,30,opt,name=amount_to_collect,json=amountToCollect,proto3" json:"amount_to_collect,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OrderConsignmentId   string                 `protobuf:"bytes,1,opt,name=order_consignment_id,json=orderConsignmentId,proto3" json:"order_consignment_id,omitempty"`
+	OrderCreatedAt       string                 `protobuf:"bytes,2,opt,name=order_created_at,json=orderCreatedAt,proto3" json:"order_created_at,omitempty"`
+	OrderDescription     string                 `protobuf:"bytes,3,opt,name=order_description,json=orderDescription,proto3" json:"order_description,omitempty"`
+	MerchantOrderId      string                 `protobuf:"bytes,4,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
+	RecipientName        string                 `protobuf:"bytes,5,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	RecipientAddress     string                 `protobuf:"bytes,6,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	RecipientPhone       string                 `protobuf:"bytes,7,opt,name=recipient_phone,json=recipientPhone,proto3" json:"recipient_phone,omitempty"`
+	OrderAmount          float64                `protobuf:"fixed64,8,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	TotalFee             float64                `protobuf:"fixed64,9,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	Instruction          string                 `protobuf:"bytes,10,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	OrderTypeId          int64                  `protobuf:"varint,11,opt,name=order_type_id,json=orderTypeId,proto3" json:"order_type_id,omitempty"`
+	CodFee               float64                `protobuf:"fixed64,12,opt,name=cod_fee,json=codFee,proto3" json:"cod_fee,omitempty"`
+	PromoDiscount        float64                `protobuf:"fixed64,13,opt,name=promo_discount,json=promoDiscount,proto3" json:"promo_discount,omitempty"`
+	Discount             float64                `protobuf:"fixed64,14,opt,name=discount,proto3" json:"discount,omitempty"`
+	DeliveryFee          float64                `protobuf:"fixed64,15,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	OrderStatus          string                 `protobuf:"bytes,16,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	OrderType            string                 `protobuf:"bytes,17,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	ItemType             int64                  `protobuf:"varint,18,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	StoreName            string                 `protobuf:"bytes,19,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	StoreContactPhone    string                 `protobuf:"bytes,20,opt,name=store_contact_phone,json=storeContactPhone,proto3" json:"store_contact_phone,omitempty"`
+	CodAmount            float64                `protobuf:"fixed64,21,opt,name=cod_amount,json=codAmount,proto3" json:"cod_amount,omitempty"`
+	DeliveryCharge       float64                `protobuf:"fixed64,22,opt,name=delivery_charge,json=deliveryCharge,proto3" json:"delivery_charge,omitempty"`
+	StoreId              int64                  `protobuf:"varint,23,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	RecipientCity        int64                  `protobuf:"varint,24,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
+	RecipientZone        int64                  `protobuf:"varint,25,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
+	RecipientArea        int64                  `protobuf:"varint,26,opt,name=recipient_area,json=recipientArea,proto3" json:"recipient_area,omitempty"`
+	DeliveryType         int64                  `protobuf:"varint,27,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
+	ItemQuantity         int64                  `protobuf:"varint,28,opt,name=item_quantity,json=itemQuantity,proto3" json:"item_quantity,omitempty"`
+	ItemWeight           float64                `protobuf:"fixed64,29,opt,name=item_weight,json=itemWeight,proto3" json:"item_weight,omitempty"`
+	AmountToCollect      float64                `protobuf:"fixed64,30,opt,name=amount_to_collect,json=amountToCollect,proto3" json:"amount_to_collect,omitempty"`
+	ArchivedAt           string                 `protobuf:"bytes,31,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	RateCardVersion      int64                  `protobuf:"varint,32,opt,name=rate_card_version,json=rateCardVersion,proto3" json:"rate_card_version,omitempty"`
+	PromoCode            string                 `protobuf:"bytes,33,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	OrderAmountMoney     *Money                 `protobuf:"bytes,34,opt,name=order_amount_money,json=orderAmountMoney,proto3" json:"order_amount_money,omitempty"`
+	TotalFeeMoney        *Money                 `protobuf:"bytes,35,opt,name=total_fee_money,json=totalFeeMoney,proto3" json:"total_fee_money,omitempty"`
+	CodFeeMoney          *Money                 `protobuf:"bytes,36,opt,name=cod_fee_money,json=codFeeMoney,proto3" json:"cod_fee_money,omitempty"`
+	PromoDiscountMoney   *Money                 `protobuf:"bytes,37,opt,name=promo_discount_money,json=promoDiscountMoney,proto3" json:"promo_discount_money,omitempty"`
+	DiscountMoney        *Money                 `protobuf:"bytes,38,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	DeliveryFeeMoney     *Money                 `protobuf:"bytes,39,opt,name=delivery_fee_money,json=deliveryFeeMoney,proto3" json:"delivery_fee_money,omitempty"`
+	CodAmountMoney       *Money                 `protobuf:"bytes,40,opt,name=cod_amount_money,json=codAmountMoney,proto3" json:"cod_amount_money,omitempty"`
+	DeliveryChargeMoney  *Money                 `protobuf:"bytes,41,opt,name=delivery_charge_money,json=deliveryChargeMoney,proto3" json:"delivery_charge_money,omitempty"`
+	AmountToCollectMoney *Money                 `protobuf:"bytes,42,opt,name=amount_to_collect_money,json=amountToCollectMoney,proto3" json:"amount_to_collect_money,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[10]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -841,7 +1195,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[10]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1208,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{10}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Order) GetOrderConsignmentId() string {
@@ -1067,6 +1421,90 @@ func (x *Order) GetAmountToCollect() float64 {
 	return 0
 }
 
+func (x *Order) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *Order) GetRateCardVersion() int64 {
+	if x != nil {
+		return x.RateCardVersion
+	}
+	return 0
+}
+
+func (x *Order) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Order) GetOrderAmountMoney() *Money {
+	if x != nil {
+		return x.OrderAmountMoney
+	}
+	return nil
+}
+
+func (x *Order) GetTotalFeeMoney() *Money {
+	if x != nil {
+		return x.TotalFeeMoney
+	}
+	return nil
+}
+
+func (x *Order) GetCodFeeMoney() *Money {
+	if x != nil {
+		return x.CodFeeMoney
+	}
+	return nil
+}
+
+func (x *Order) GetPromoDiscountMoney() *Money {
+	if x != nil {
+		return x.PromoDiscountMoney
+	}
+	return nil
+}
+
+func (x *Order) GetDiscountMoney() *Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *Order) GetDeliveryFeeMoney() *Money {
+	if x != nil {
+		return x.DeliveryFeeMoney
+	}
+	return nil
+}
+
+func (x *Order) GetCodAmountMoney() *Money {
+	if x != nil {
+		return x.CodAmountMoney
+	}
+	return nil
+}
+
+func (x *Order) GetDeliveryChargeMoney() *Money {
+	if x != nil {
+		return x.DeliveryChargeMoney
+	}
+	return nil
+}
+
+func (x *Order) GetAmountToCollectMoney() *Money {
+	if x != nil {
+		return x.AmountToCollectMoney
+	}
+	return nil
+}
+
 type CancelOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ConsignmentId string                 `protobuf:"bytes,1,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
@@ -1076,7 +1514,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[11]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1526,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[11]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1539,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{11}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CancelOrderRequest) GetConsignmentId() string {
@@ -1122,7 +1560,7 @@ type CancelOrderResponse struct {
 
 func (x *CancelOrderResponse) Reset() {
 	*x = CancelOrderResponse{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[12]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1134,7 +1572,7 @@ func (x *CancelOrderResponse) String() string {
 func (*CancelOrderResponse) ProtoMessage() {}
 
 func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[12]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1585,7 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
 func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{12}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CancelOrderResponse) GetMessage() string {
@@ -1179,7 +1617,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[13]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1629,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[13]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1642,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{13}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{16}
 }
 
 type LogoutResponse struct {
@@ -1218,7 +1656,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[14]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1230,7 +1668,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[14]
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1681,7 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{14}
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *LogoutResponse) GetMessage() string {
@@ -1267,97 +1705,5771 @@ func (x *LogoutResponse) GetCode() int32 {
 	return 0
 }
 
-var File_internal_adapters_grpc_proto_order_proto protoreflect.FileDescriptor
+type UpdateUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_internal_adapters_grpc_proto_order_proto_rawDesc = "" +
-	"\n" +
-	"(internal/adapters/grpc/proto/order.proto\x12\x05order\"G\n" +
-	"\rSignupRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"R\n" +
-	"\x0eSignupResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\x05R\x04code\"F\n" +
-	"\fLoginRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd7\x01\n" +
-	"\rLoginResponse\x12\x1d\n" +
-	"\n" +
-	"token_type\x18\x01 \x01(\tR\ttokenType\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12!\n" +
-	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x06 \x01(\tR\x04type\x12\x12\n" +
-	"\x04code\x18\a \x01(\x05R\x04code\"\xdd\x04\n" +
-	"\x12CreateOrderRequest\x12\x19\n" +
-	"\bstore_id\x18\x01 \x01(\x03R\astoreId\x12*\n" +
-	"\x11merchant_order_id\x18\x02 \x01(\tR\x0fmerchantOrderId\x12%\n" +
-	"\x0erecipient_name\x18\x03 \x01(\tR\rrecipientName\x12'\n" +
-	"\x0frecipient_phone\x18\x04 \x01(\tR\x0erecipientPhone\x12+\n" +
-	"\x11recipient_address\x18\x05 \x01(\tR\x10recipientAddress\x12%\n" +
-	"\x0erecipient_city\x18\x06 \x01(\x03R\rrecipientCity\x12%\n" +
-	"\x0erecipient_zone\x18\a \x01(\x03R\rrecipientZone\x12%\n" +
-	"\x0erecipient_area\x18\b \x01(\x03R\rrecipientArea\x12#\n" +
-	"\rdelivery_type\x18\t \x01(\x03R\fdeliveryType\x12\x1b\n" +
-	"\titem_type\x18\n" +
-	" \x01(\x03R\bitemType\x12/\n" +
-	"\x13special_instruction\x18\v \x01(\tR\x12specialInstruction\x12#\n" +
-	"\ritem_quantity\x18\f \x01(\x03R\fitemQuantity\x12\x1f\n" +
-	"\vitem_weight\x18\r \x01(\x01R\n" +
-	"itemWeight\x12*\n" +
-	"\x11amount_to_collect\x18\x0e \x01(\x01R\x0famountToCollect\x12)\n" +
-	"\x10item_description\x18\x0f \x01(\tR\x0fitemDescription\"}\n" +
-	"\x13CreateOrderResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\x05R\x04code\x12$\n" +
-	"\x04data\x18\x04 \x01(\v2\x10.order.OrderDataR\x04data\"\xa4\x01\n" +
-	"\tOrderData\x12%\n" +
-	"\x0econsignment_id\x18\x01 \x01(\tR\rconsignmentId\x12*\n" +
-	"\x11merchant_order_id\x18\x02 \x01(\tR\x0fmerchantOrderId\x12!\n" +
-	"\forder_status\x18\x03 \x01(\tR\vorderStatus\x12!\n" +
-	"\fdelivery_fee\x18\x04 \x01(\x01R\vdeliveryFee\"\x80\x01\n" +
-	"\x11ListOrdersRequest\x12'\n" +
-	"\x0ftransfer_status\x18\x01 \x01(\x03R\x0etransferStatus\x12\x18\n" +
-	"\aarchive\x18\x02 \x01(\x03R\aarchive\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x12\n" +
-	"\x04page\x18\x04 \x01(\x03R\x04page\"}\n" +
-	"\x12ListOrdersResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\x05R\x04code\x12%\n" +
-	"\x04data\x18\x04 \x01(\v2\x11.order.OrdersDataR\x04data\"\xc7\x01\n" +
-	"\n" +
-	"OrdersData\x12$\n" +
-	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\x12!\n" +
-	"\fcurrent_page\x18\x03 \x01(\x03R\vcurrentPage\x12\x19\n" +
-	"\bper_page\x18\x04 \x01(\x03R\aperPage\x12\"\n" +
-	"\rtotal_in_page\x18\x05 \x01(\x03R\vtotalInPage\x12\x1b\n" +
-	"\tlast_page\x18\x06 \x01(\x03R\blastPage\"\xdb\b\n" +
-	"\x05Order\x120\n" +
-	"\x14order_consignment_id\x18\x01 \x01(\tR\x12orderConsignmentId\x12(\n" +
-	"\x10order_created_at\x18\x02 \x01(\tR\x0eorderCreatedAt\x12+\n" +
-	"\x11order_description\x18\x03 \x01(\tR\x10orderDescription\x12*\n" +
-	"\x11merchant_order_id\x18\x04 \x01(\tR\x0fmerchantOrderId\x12%\n" +
-	"\x0erecipient_name\x18\x05 \x01(\tR\rrecipientName\x12+\n" +
-	"\x11recipient_address\x18\x06 \x01(\tR\x10recipientAddress\x12'\n" +
-	"\x0frecipient_phone\x18\a \x01(\tR\x0erecipientPhone\x12!\n" +
-	"\forder_amount\x18\b \x01(\x01R\vorderAmount\x12\x1b\n" +
-	"\ttotal_fee\x18\t \x01(\x01R\btotalFee\x12 \n" +
-	"\vinstruction\x18\n" +
-	" \x01(\tR\vinstruction\x12\"\n" +
-	"\rorder_type_id\x18\v \x01(\x03R\vorderTypeId\x12\x17\n" +
-	"\acod_fee\x18\f \x01(\x01R\x06codFee\x12%\n" +
-	"\x0epromo_discount\x18\r \x01(\x01R\rpromoDiscount\x12\x1a\n" +
-	"\bdiscount\x18\x0e \x01(\x01R\bdiscount\x12!\n" +
-	"\fdelivery_fee\x18\x0f \x01(\x01R\vdeliveryFee\x12!\n" +
-	"\forder_status\x18\x10 \x01(\tR\vorderStatus\x12\x1d\n" +
-	"\n" +
-	"order_type\x18\x11 \x01(\tR\torderType\x12\x1b\n" +
+func (x *UpdateUserRolesRequest) Reset() {
+	*x = UpdateUserRolesRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRolesRequest) ProtoMessage() {}
+
+func (x *UpdateUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateUserRolesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type UpdateUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRolesResponse) Reset() {
+	*x = UpdateUserRolesResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRolesResponse) ProtoMessage() {}
+
+func (x *UpdateUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateUserRolesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateUserRolesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateUserRolesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	StoreId       int64                  `protobuf:"varint,4,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Methods       []string               `protobuf:"bytes,5,rep,name=methods,proto3" json:"methods,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     string                 `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApiKey) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ApiKey) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StoreId       int64                  `protobuf:"varint,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Methods       []string               `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *CreateApiKeyRequest) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *ApiKey                `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateApiKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateApiKeyResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateApiKeyResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateApiKeyResponse) GetData() *ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{23}
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*ApiKey              `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListApiKeysResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListApiKeysResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListApiKeysResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListApiKeysResponse) GetData() []*ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeApiKeyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeApiKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevokeApiKeyResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RevokeApiKeyResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type UnlockAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnlockAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnlockAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountResponse) Reset() {
+	*x = UnlockAccountResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountResponse) ProtoMessage() {}
+
+func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnlockAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnlockAccountResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UnlockAccountResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChangePasswordResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChangePasswordResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequestPasswordResetRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RequestPasswordResetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResetPasswordResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResetPasswordResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifyEmailRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifyEmailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyEmailResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VerifyEmailResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type SendVerificationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeRequest) Reset() {
+	*x = SendVerificationCodeRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeRequest) ProtoMessage() {}
+
+func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{37}
+}
+
+type SendVerificationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeResponse) Reset() {
+	*x = SendVerificationCodeResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeResponse) ProtoMessage() {}
+
+func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationCodeResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SendVerificationCodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendVerificationCodeResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendVerificationCodeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Session) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{40}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*Session             `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSessionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListSessionsResponse) GetData() []*Session {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RevokeSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevokeSessionResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RevokeSessionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type EnrollTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTwoFactorRequest) Reset() {
+	*x = EnrollTwoFactorRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTwoFactorRequest) ProtoMessage() {}
+
+func (x *EnrollTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{44}
+}
+
+type EnrollTwoFactorResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Message         string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code            int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Secret          string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	ProvisioningUri string                 `protobuf:"bytes,5,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EnrollTwoFactorResponse) Reset() {
+	*x = EnrollTwoFactorResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTwoFactorResponse) ProtoMessage() {}
+
+func (x *EnrollTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EnrollTwoFactorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EnrollTwoFactorResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EnrollTwoFactorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EnrollTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTwoFactorResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+type ConfirmTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTwoFactorRequest) Reset() {
+	*x = ConfirmTwoFactorRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTwoFactorRequest) ProtoMessage() {}
+
+func (x *ConfirmTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ConfirmTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,4,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTwoFactorResponse) Reset() {
+	*x = ConfirmTwoFactorResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTwoFactorResponse) ProtoMessage() {}
+
+func (x *ConfirmTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConfirmTwoFactorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfirmTwoFactorResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConfirmTwoFactorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ConfirmTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifyTwoFactorRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeToken string                 `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFactorRequest) Reset() {
+	*x = VerifyTwoFactorRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorRequest) ProtoMessage() {}
+
+func (x *VerifyTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VerifyTwoFactorRequest) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenType     string                 `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFactorResponse) Reset() {
+	*x = VerifyTwoFactorResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorResponse) ProtoMessage() {}
+
+func (x *VerifyTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VerifyTwoFactorResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *VerifyTwoFactorResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type DisableTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorRequest) Reset() {
+	*x = DisableTwoFactorRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorRequest) ProtoMessage() {}
+
+func (x *DisableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DisableTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorResponse) Reset() {
+	*x = DisableTwoFactorResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorResponse) ProtoMessage() {}
+
+func (x *DisableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DisableTwoFactorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DisableTwoFactorResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DisableTwoFactorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,4,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsignmentId string                 `protobuf:"bytes,1,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateOrderStatusRequest) GetConsignmentId() string {
+	if x != nil {
+		return x.ConsignmentId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *OrderData             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateOrderStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateOrderStatusResponse) GetData() *OrderData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OrderStatusEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus string                 `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus   string                 `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	ActorId    int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason     string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt  string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set for edits of the order, which keep its status.
+	Changes       []*OrderFieldChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusEvent) Reset() {
+	*x = OrderStatusEvent{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusEvent) ProtoMessage() {}
+
+func (x *OrderStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusEvent.ProtoReflect.Descriptor instead.
+func (*OrderStatusEvent) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *OrderStatusEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *OrderStatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetChanges() []*OrderFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type OrderFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderFieldChange) Reset() {
+	*x = OrderFieldChange{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFieldChange) ProtoMessage() {}
+
+func (x *OrderFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFieldChange.ProtoReflect.Descriptor instead.
+func (*OrderFieldChange) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *OrderFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *OrderFieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *OrderFieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// UpdateOrderRequest replaces the editable details of a pending order. Every
+// field is required as in CreateOrderRequest; the store, merchant order ID and
+// promo code cannot change.
+type UpdateOrderRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ConsignmentId        string                 `protobuf:"bytes,1,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
+	RecipientName        string                 `protobuf:"bytes,2,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	RecipientPhone       string                 `protobuf:"bytes,3,opt,name=recipient_phone,json=recipientPhone,proto3" json:"recipient_phone,omitempty"`
+	RecipientAddress     string                 `protobuf:"bytes,4,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	RecipientCity        int64                  `protobuf:"varint,5,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
+	RecipientZone        int64                  `protobuf:"varint,6,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
+	RecipientArea        int64                  `protobuf:"varint,7,opt,name=recipient_area,json=recipientArea,proto3" json:"recipient_area,omitempty"`
+	DeliveryType         int64                  `protobuf:"varint,8,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
+	ItemType             int64                  `protobuf:"varint,9,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	SpecialInstruction   string                 `protobuf:"bytes,10,opt,name=special_instruction,json=specialInstruction,proto3" json:"special_instruction,omitempty"`
+	ItemQuantity         int64                  `protobuf:"varint,11,opt,name=item_quantity,json=itemQuantity,proto3" json:"item_quantity,omitempty"`
+	ItemWeight           float64                `protobuf:"fixed64,12,opt,name=item_weight,json=itemWeight,proto3" json:"item_weight,omitempty"`
+	AmountToCollect      float64                `protobuf:"fixed64,13,opt,name=amount_to_collect,json=amountToCollect,proto3" json:"amount_to_collect,omitempty"`
+	ItemDescription      string                 `protobuf:"bytes,14,opt,name=item_description,json=itemDescription,proto3" json:"item_description,omitempty"`
+	AmountToCollectMoney *Money                 `protobuf:"bytes,15,opt,name=amount_to_collect_money,json=amountToCollectMoney,proto3" json:"amount_to_collect_money,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateOrderRequest) Reset() {
+	*x = UpdateOrderRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderRequest) ProtoMessage() {}
+
+func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateOrderRequest) GetConsignmentId() string {
+	if x != nil {
+		return x.ConsignmentId
+	}
+	return ""
+}
+
+func (x *UpdateOrderRequest) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *UpdateOrderRequest) GetRecipientPhone() string {
+	if x != nil {
+		return x.RecipientPhone
+	}
+	return ""
+}
+
+func (x *UpdateOrderRequest) GetRecipientAddress() string {
+	if x != nil {
+		return x.RecipientAddress
+	}
+	return ""
+}
+
+func (x *UpdateOrderRequest) GetRecipientCity() int64 {
+	if x != nil {
+		return x.RecipientCity
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetRecipientZone() int64 {
+	if x != nil {
+		return x.RecipientZone
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetRecipientArea() int64 {
+	if x != nil {
+		return x.RecipientArea
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetDeliveryType() int64 {
+	if x != nil {
+		return x.DeliveryType
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetItemType() int64 {
+	if x != nil {
+		return x.ItemType
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetSpecialInstruction() string {
+	if x != nil {
+		return x.SpecialInstruction
+	}
+	return ""
+}
+
+func (x *UpdateOrderRequest) GetItemQuantity() int64 {
+	if x != nil {
+		return x.ItemQuantity
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetItemWeight() float64 {
+	if x != nil {
+		return x.ItemWeight
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetAmountToCollect() float64 {
+	if x != nil {
+		return x.AmountToCollect
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetItemDescription() string {
+	if x != nil {
+		return x.ItemDescription
+	}
+	return ""
+}
+
+func (x *UpdateOrderRequest) GetAmountToCollectMoney() *Money {
+	if x != nil {
+		return x.AmountToCollectMoney
+	}
+	return nil
+}
+
+type UpdateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *Order                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderResponse) Reset() {
+	*x = UpdateOrderResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderResponse) ProtoMessage() {}
+
+func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateOrderResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateOrderResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsignmentId string                 `protobuf:"bytes,1,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetOrderRequest) GetConsignmentId() string {
+	if x != nil {
+		return x.ConsignmentId
+	}
+	return ""
+}
+
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *Order                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	History       []*OrderStatusEvent    `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetHistory() []*OrderStatusEvent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ArchiveOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConsignmentIds []string               `protobuf:"bytes,1,rep,name=consignment_ids,json=consignmentIds,proto3" json:"consignment_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchiveOrdersRequest) Reset() {
+	*x = ArchiveOrdersRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveOrdersRequest) ProtoMessage() {}
+
+func (x *ArchiveOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ArchiveOrdersRequest) GetConsignmentIds() []string {
+	if x != nil {
+		return x.ConsignmentIds
+	}
+	return nil
+}
+
+type ArchiveOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code           int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	ConsignmentIds []string               `protobuf:"bytes,4,rep,name=consignment_ids,json=consignmentIds,proto3" json:"consignment_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchiveOrdersResponse) Reset() {
+	*x = ArchiveOrdersResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveOrdersResponse) ProtoMessage() {}
+
+func (x *ArchiveOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ArchiveOrdersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ArchiveOrdersResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ArchiveOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ArchiveOrdersResponse) GetConsignmentIds() []string {
+	if x != nil {
+		return x.ConsignmentIds
+	}
+	return nil
+}
+
+type UnarchiveOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConsignmentIds []string               `protobuf:"bytes,1,rep,name=consignment_ids,json=consignmentIds,proto3" json:"consignment_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnarchiveOrdersRequest) Reset() {
+	*x = UnarchiveOrdersRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveOrdersRequest) ProtoMessage() {}
+
+func (x *UnarchiveOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveOrdersRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UnarchiveOrdersRequest) GetConsignmentIds() []string {
+	if x != nil {
+		return x.ConsignmentIds
+	}
+	return nil
+}
+
+type UnarchiveOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code           int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	ConsignmentIds []string               `protobuf:"bytes,4,rep,name=consignment_ids,json=consignmentIds,proto3" json:"consignment_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnarchiveOrdersResponse) Reset() {
+	*x = UnarchiveOrdersResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveOrdersResponse) ProtoMessage() {}
+
+func (x *UnarchiveOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveOrdersResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UnarchiveOrdersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnarchiveOrdersResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UnarchiveOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UnarchiveOrdersResponse) GetConsignmentIds() []string {
+	if x != nil {
+		return x.ConsignmentIds
+	}
+	return nil
+}
+
+type BulkOrderResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the order in the stream or CSV file, counting from 1.
+	Row             int64   `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	ConsignmentId   string  `protobuf:"bytes,2,opt,name=consignment_id,json=consignmentId,proto3" json:"consignment_id,omitempty"`
+	MerchantOrderId string  `protobuf:"bytes,3,opt,name=merchant_order_id,json=merchantOrderId,proto3" json:"merchant_order_id,omitempty"`
+	OrderStatus     string  `protobuf:"bytes,4,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	DeliveryFee     float64 `protobuf:"fixed64,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	// Set when the row was rejected; the other fields are empty then.
+	Error            string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	DeliveryFeeMoney *Money `protobuf:"bytes,7,opt,name=delivery_fee_money,json=deliveryFeeMoney,proto3" json:"delivery_fee_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BulkOrderResult) Reset() {
+	*x = BulkOrderResult{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOrderResult) ProtoMessage() {}
+
+func (x *BulkOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOrderResult.ProtoReflect.Descriptor instead.
+func (*BulkOrderResult) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BulkOrderResult) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *BulkOrderResult) GetConsignmentId() string {
+	if x != nil {
+		return x.ConsignmentId
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetMerchantOrderId() string {
+	if x != nil {
+		return x.MerchantOrderId
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetDeliveryFee() float64 {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return 0
+}
+
+func (x *BulkOrderResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkOrderResult) GetDeliveryFeeMoney() *Money {
+	if x != nil {
+		return x.DeliveryFeeMoney
+	}
+	return nil
+}
+
+type BulkCreateOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Created       int64                  `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        int64                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*BulkOrderResult     `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateOrdersResponse) Reset() {
+	*x = BulkCreateOrdersResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateOrdersResponse) ProtoMessage() {}
+
+func (x *BulkCreateOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateOrdersResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BulkCreateOrdersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkCreateOrdersResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BulkCreateOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BulkCreateOrdersResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkCreateOrdersResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkCreateOrdersResponse) GetResults() []*BulkOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ImportOrdersCsvRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersCsvRequest) Reset() {
+	*x = ImportOrdersCsvRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersCsvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersCsvRequest) ProtoMessage() {}
+
+func (x *ImportOrdersCsvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersCsvRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersCsvRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ImportOrdersCsvRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type WeightBand struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxWeight      float64                `protobuf:"fixed64,1,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	Surcharge      float64                `protobuf:"fixed64,2,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
+	SurchargeMoney *Money                 `protobuf:"bytes,3,opt,name=surcharge_money,json=surchargeMoney,proto3" json:"surcharge_money,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeightBand) Reset() {
+	*x = WeightBand{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightBand) ProtoMessage() {}
+
+func (x *WeightBand) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightBand.ProtoReflect.Descriptor instead.
+func (*WeightBand) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *WeightBand) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *WeightBand) GetSurcharge() float64 {
+	if x != nil {
+		return x.Surcharge
+	}
+	return 0
+}
+
+func (x *WeightBand) GetSurchargeMoney() *Money {
+	if x != nil {
+		return x.SurchargeMoney
+	}
+	return nil
+}
+
+type Rate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	City               int64                  `protobuf:"varint,1,opt,name=city,proto3" json:"city,omitempty"`
+	Zone               int64                  `protobuf:"varint,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	Area               int64                  `protobuf:"varint,3,opt,name=area,proto3" json:"area,omitempty"`
+	DeliveryType       int64                  `protobuf:"varint,4,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
+	ItemType           int64                  `protobuf:"varint,5,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	BaseFee            float64                `protobuf:"fixed64,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	WeightBands        []*WeightBand          `protobuf:"bytes,7,rep,name=weight_bands,json=weightBands,proto3" json:"weight_bands,omitempty"`
+	ExtraKgFee         float64                `protobuf:"fixed64,8,opt,name=extra_kg_fee,json=extraKgFee,proto3" json:"extra_kg_fee,omitempty"`
+	OverweightFee      float64                `protobuf:"fixed64,9,opt,name=overweight_fee,json=overweightFee,proto3" json:"overweight_fee,omitempty"`
+	BaseFeeMoney       *Money                 `protobuf:"bytes,10,opt,name=base_fee_money,json=baseFeeMoney,proto3" json:"base_fee_money,omitempty"`
+	ExtraKgFeeMoney    *Money                 `protobuf:"bytes,11,opt,name=extra_kg_fee_money,json=extraKgFeeMoney,proto3" json:"extra_kg_fee_money,omitempty"`
+	OverweightFeeMoney *Money                 `protobuf:"bytes,12,opt,name=overweight_fee_money,json=overweightFeeMoney,proto3" json:"overweight_fee_money,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Rate) Reset() {
+	*x = Rate{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rate) ProtoMessage() {}
+
+func (x *Rate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rate.ProtoReflect.Descriptor instead.
+func (*Rate) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Rate) GetCity() int64 {
+	if x != nil {
+		return x.City
+	}
+	return 0
+}
+
+func (x *Rate) GetZone() int64 {
+	if x != nil {
+		return x.Zone
+	}
+	return 0
+}
+
+func (x *Rate) GetArea() int64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Rate) GetDeliveryType() int64 {
+	if x != nil {
+		return x.DeliveryType
+	}
+	return 0
+}
+
+func (x *Rate) GetItemType() int64 {
+	if x != nil {
+		return x.ItemType
+	}
+	return 0
+}
+
+func (x *Rate) GetBaseFee() float64 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
+}
+
+func (x *Rate) GetWeightBands() []*WeightBand {
+	if x != nil {
+		return x.WeightBands
+	}
+	return nil
+}
+
+func (x *Rate) GetExtraKgFee() float64 {
+	if x != nil {
+		return x.ExtraKgFee
+	}
+	return 0
+}
+
+func (x *Rate) GetOverweightFee() float64 {
+	if x != nil {
+		return x.OverweightFee
+	}
+	return 0
+}
+
+func (x *Rate) GetBaseFeeMoney() *Money {
+	if x != nil {
+		return x.BaseFeeMoney
+	}
+	return nil
+}
+
+func (x *Rate) GetExtraKgFeeMoney() *Money {
+	if x != nil {
+		return x.ExtraKgFeeMoney
+	}
+	return nil
+}
+
+func (x *Rate) GetOverweightFeeMoney() *Money {
+	if x != nil {
+		return x.OverweightFeeMoney
+	}
+	return nil
+}
+
+type RateCard struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Version             int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	EffectiveFrom       string                 `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CodPercent          float64                `protobuf:"fixed64,3,opt,name=cod_percent,json=codPercent,proto3" json:"cod_percent,omitempty"`
+	MinCodFee           float64                `protobuf:"fixed64,4,opt,name=min_cod_fee,json=minCodFee,proto3" json:"min_cod_fee,omitempty"`
+	MinDeliveryFee      float64                `protobuf:"fixed64,5,opt,name=min_delivery_fee,json=minDeliveryFee,proto3" json:"min_delivery_fee,omitempty"`
+	Rates               []*Rate                `protobuf:"bytes,6,rep,name=rates,proto3" json:"rates,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MinCodFeeMoney      *Money                 `protobuf:"bytes,8,opt,name=min_cod_fee_money,json=minCodFeeMoney,proto3" json:"min_cod_fee_money,omitempty"`
+	MinDeliveryFeeMoney *Money                 `protobuf:"bytes,9,opt,name=min_delivery_fee_money,json=minDeliveryFeeMoney,proto3" json:"min_delivery_fee_money,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RateCard) Reset() {
+	*x = RateCard{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCard) ProtoMessage() {}
+
+func (x *RateCard) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCard.ProtoReflect.Descriptor instead.
+func (*RateCard) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RateCard) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RateCard) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *RateCard) GetCodPercent() float64 {
+	if x != nil {
+		return x.CodPercent
+	}
+	return 0
+}
+
+func (x *RateCard) GetMinCodFee() float64 {
+	if x != nil {
+		return x.MinCodFee
+	}
+	return 0
+}
+
+func (x *RateCard) GetMinDeliveryFee() float64 {
+	if x != nil {
+		return x.MinDeliveryFee
+	}
+	return 0
+}
+
+func (x *RateCard) GetRates() []*Rate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *RateCard) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RateCard) GetMinCodFeeMoney() *Money {
+	if x != nil {
+		return x.MinCodFeeMoney
+	}
+	return nil
+}
+
+func (x *RateCard) GetMinDeliveryFeeMoney() *Money {
+	if x != nil {
+		return x.MinDeliveryFeeMoney
+	}
+	return nil
+}
+
+type CreateRateCardRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EffectiveFrom       string                 `protobuf:"bytes,1,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CodPercent          float64                `protobuf:"fixed64,2,opt,name=cod_percent,json=codPercent,proto3" json:"cod_percent,omitempty"`
+	MinCodFee           float64                `protobuf:"fixed64,3,opt,name=min_cod_fee,json=minCodFee,proto3" json:"min_cod_fee,omitempty"`
+	MinDeliveryFee      float64                `protobuf:"fixed64,4,opt,name=min_delivery_fee,json=minDeliveryFee,proto3" json:"min_delivery_fee,omitempty"`
+	Rates               []*Rate                `protobuf:"bytes,5,rep,name=rates,proto3" json:"rates,omitempty"`
+	MinCodFeeMoney      *Money                 `protobuf:"bytes,6,opt,name=min_cod_fee_money,json=minCodFeeMoney,proto3" json:"min_cod_fee_money,omitempty"`
+	MinDeliveryFeeMoney *Money                 `protobuf:"bytes,7,opt,name=min_delivery_fee_money,json=minDeliveryFeeMoney,proto3" json:"min_delivery_fee_money,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateRateCardRequest) Reset() {
+	*x = CreateRateCardRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateCardRequest) ProtoMessage() {}
+
+func (x *CreateRateCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateCardRequest.ProtoReflect.Descriptor instead.
+func (*CreateRateCardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateRateCardRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *CreateRateCardRequest) GetCodPercent() float64 {
+	if x != nil {
+		return x.CodPercent
+	}
+	return 0
+}
+
+func (x *CreateRateCardRequest) GetMinCodFee() float64 {
+	if x != nil {
+		return x.MinCodFee
+	}
+	return 0
+}
+
+func (x *CreateRateCardRequest) GetMinDeliveryFee() float64 {
+	if x != nil {
+		return x.MinDeliveryFee
+	}
+	return 0
+}
+
+func (x *CreateRateCardRequest) GetRates() []*Rate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *CreateRateCardRequest) GetMinCodFeeMoney() *Money {
+	if x != nil {
+		return x.MinCodFeeMoney
+	}
+	return nil
+}
+
+func (x *CreateRateCardRequest) GetMinDeliveryFeeMoney() *Money {
+	if x != nil {
+		return x.MinDeliveryFeeMoney
+	}
+	return nil
+}
+
+type CreateRateCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *RateCard              `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRateCardResponse) Reset() {
+	*x = CreateRateCardResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateCardResponse) ProtoMessage() {}
+
+func (x *CreateRateCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateCardResponse.ProtoReflect.Descriptor instead.
+func (*CreateRateCardResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateRateCardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateRateCardResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateRateCardResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateRateCardResponse) GetData() *RateCard {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListRateCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateCardsRequest) Reset() {
+	*x = ListRateCardsRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateCardsRequest) ProtoMessage() {}
+
+func (x *ListRateCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListRateCardsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{74}
+}
+
+type ListRateCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*RateCard            `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateCardsResponse) Reset() {
+	*x = ListRateCardsResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateCardsResponse) ProtoMessage() {}
+
+func (x *ListRateCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateCardsResponse.ProtoReflect.Descriptor instead.
+func (*ListRateCardsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListRateCardsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListRateCardsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListRateCardsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListRateCardsResponse) GetData() []*RateCard {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type QuoteDeliveryFeeRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StoreId              int64                  `protobuf:"varint,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	RecipientCity        int64                  `protobuf:"varint,2,opt,name=recipient_city,json=recipientCity,proto3" json:"recipient_city,omitempty"`
+	RecipientZone        int64                  `protobuf:"varint,3,opt,name=recipient_zone,json=recipientZone,proto3" json:"recipient_zone,omitempty"`
+	RecipientArea        int64                  `protobuf:"varint,4,opt,name=recipient_area,json=recipientArea,proto3" json:"recipient_area,omitempty"`
+	DeliveryType         int64                  `protobuf:"varint,5,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
+	ItemType             int64                  `protobuf:"varint,6,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	ItemQuantity         int64                  `protobuf:"varint,7,opt,name=item_quantity,json=itemQuantity,proto3" json:"item_quantity,omitempty"`
+	ItemWeight           float64                `protobuf:"fixed64,8,opt,name=item_weight,json=itemWeight,proto3" json:"item_weight,omitempty"`
+	AmountToCollect      float64                `protobuf:"fixed64,9,opt,name=amount_to_collect,json=amountToCollect,proto3" json:"amount_to_collect,omitempty"`
+	PromoCode            string                 `protobuf:"bytes,10,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	AmountToCollectMoney *Money                 `protobuf:"bytes,11,opt,name=amount_to_collect_money,json=amountToCollectMoney,proto3" json:"amount_to_collect_money,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuoteDeliveryFeeRequest) Reset() {
+	*x = QuoteDeliveryFeeRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDeliveryFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDeliveryFeeRequest) ProtoMessage() {}
+
+func (x *QuoteDeliveryFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDeliveryFeeRequest.ProtoReflect.Descriptor instead.
+func (*QuoteDeliveryFeeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *QuoteDeliveryFeeRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetRecipientCity() int64 {
+	if x != nil {
+		return x.RecipientCity
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetRecipientZone() int64 {
+	if x != nil {
+		return x.RecipientZone
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetRecipientArea() int64 {
+	if x != nil {
+		return x.RecipientArea
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetDeliveryType() int64 {
+	if x != nil {
+		return x.DeliveryType
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetItemType() int64 {
+	if x != nil {
+		return x.ItemType
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetItemQuantity() int64 {
+	if x != nil {
+		return x.ItemQuantity
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetItemWeight() float64 {
+	if x != nil {
+		return x.ItemWeight
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetAmountToCollect() float64 {
+	if x != nil {
+		return x.AmountToCollect
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *QuoteDeliveryFeeRequest) GetAmountToCollectMoney() *Money {
+	if x != nil {
+		return x.AmountToCollectMoney
+	}
+	return nil
+}
+
+type FeeQuote struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BaseFee              float64                `protobuf:"fixed64,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	WeightSurcharge      float64                `protobuf:"fixed64,2,opt,name=weight_surcharge,json=weightSurcharge,proto3" json:"weight_surcharge,omitempty"`
+	DeliveryFee          float64                `protobuf:"fixed64,3,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	CodFee               float64                `protobuf:"fixed64,4,opt,name=cod_fee,json=codFee,proto3" json:"cod_fee,omitempty"`
+	Discount             float64                `protobuf:"fixed64,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	TotalFee             float64                `protobuf:"fixed64,6,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	RateCardVersion      int64                  `protobuf:"varint,7,opt,name=rate_card_version,json=rateCardVersion,proto3" json:"rate_card_version,omitempty"`
+	PromoDiscount        float64                `protobuf:"fixed64,8,opt,name=promo_discount,json=promoDiscount,proto3" json:"promo_discount,omitempty"`
+	BaseFeeMoney         *Money                 `protobuf:"bytes,9,opt,name=base_fee_money,json=baseFeeMoney,proto3" json:"base_fee_money,omitempty"`
+	WeightSurchargeMoney *Money                 `protobuf:"bytes,10,opt,name=weight_surcharge_money,json=weightSurchargeMoney,proto3" json:"weight_surcharge_money,omitempty"`
+	DeliveryFeeMoney     *Money                 `protobuf:"bytes,11,opt,name=delivery_fee_money,json=deliveryFeeMoney,proto3" json:"delivery_fee_money,omitempty"`
+	CodFeeMoney          *Money                 `protobuf:"bytes,12,opt,name=cod_fee_money,json=codFeeMoney,proto3" json:"cod_fee_money,omitempty"`
+	DiscountMoney        *Money                 `protobuf:"bytes,13,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TotalFeeMoney        *Money                 `protobuf:"bytes,14,opt,name=total_fee_money,json=totalFeeMoney,proto3" json:"total_fee_money,omitempty"`
+	PromoDiscountMoney   *Money                 `protobuf:"bytes,15,opt,name=promo_discount_money,json=promoDiscountMoney,proto3" json:"promo_discount_money,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *FeeQuote) Reset() {
+	*x = FeeQuote{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeQuote) ProtoMessage() {}
+
+func (x *FeeQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeQuote.ProtoReflect.Descriptor instead.
+func (*FeeQuote) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FeeQuote) GetBaseFee() float64 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetWeightSurcharge() float64 {
+	if x != nil {
+		return x.WeightSurcharge
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetDeliveryFee() float64 {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetCodFee() float64 {
+	if x != nil {
+		return x.CodFee
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetTotalFee() float64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetRateCardVersion() int64 {
+	if x != nil {
+		return x.RateCardVersion
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetPromoDiscount() float64 {
+	if x != nil {
+		return x.PromoDiscount
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetBaseFeeMoney() *Money {
+	if x != nil {
+		return x.BaseFeeMoney
+	}
+	return nil
+}
+
+func (x *FeeQuote) GetWeightSurchargeMoney() *Money {
+	if x != nil {
+		return x.WeightSurchargeMoney
+	}
+	return nil
+}
+
+func (x *FeeQuote) GetDeliveryFeeMoney() *Money {
+	if x != nil {
+		return x.DeliveryFeeMoney
+	}
+	return nil
+}
+
+func (x *FeeQuote) GetCodFeeMoney() *Money {
+	if x != nil {
+		return x.CodFeeMoney
+	}
+	return nil
+}
+
+func (x *FeeQuote) GetDiscountMoney() *Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *FeeQuote) GetTotalFeeMoney() *Money {
+	if x != nil {
+		return x.TotalFeeMoney
+	}
+	return nil
+}
+
+func (x *FeeQuote) GetPromoDiscountMoney() *Money {
+	if x != nil {
+		return x.PromoDiscountMoney
+	}
+	return nil
+}
+
+type QuoteDeliveryFeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *FeeQuote              `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDeliveryFeeResponse) Reset() {
+	*x = QuoteDeliveryFeeResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDeliveryFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDeliveryFeeResponse) ProtoMessage() {}
+
+func (x *QuoteDeliveryFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDeliveryFeeResponse.ProtoReflect.Descriptor instead.
+func (*QuoteDeliveryFeeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *QuoteDeliveryFeeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QuoteDeliveryFeeResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuoteDeliveryFeeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *QuoteDeliveryFeeResponse) GetData() *FeeQuote {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A percent promotion takes value percent off the delivery fee, up to
+// max_discount if set; a flat one takes value off it. Zero caps mean no cap,
+// and empty cities or delivery_types mean any.
+type Promotion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Kind           string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value          float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	MaxDiscount    float64                `protobuf:"fixed64,5,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	StartsAt       string                 `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         string                 `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxRedemptions int64                  `protobuf:"varint,8,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxPerMerchant int64                  `protobuf:"varint,9,opt,name=max_per_merchant,json=maxPerMerchant,proto3" json:"max_per_merchant,omitempty"`
+	MerchantId     int64                  `protobuf:"varint,10,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Cities         []int64                `protobuf:"varint,11,rep,packed,name=cities,proto3" json:"cities,omitempty"`
+	DeliveryTypes  []int64                `protobuf:"varint,12,rep,packed,name=delivery_types,json=deliveryTypes,proto3" json:"delivery_types,omitempty"`
+	Redemptions    int64                  `protobuf:"varint,13,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The amount off of a flat promotion, also sent in value.
+	AmountMoney      *Money `protobuf:"bytes,15,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	MaxDiscountMoney *Money `protobuf:"bytes,16,opt,name=max_discount_money,json=maxDiscountMoney,proto3" json:"max_discount_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Promotion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Promotion) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *Promotion) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetMaxRedemptions() int64 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxPerMerchant() int64 {
+	if x != nil {
+		return x.MaxPerMerchant
+	}
+	return 0
+}
+
+func (x *Promotion) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Promotion) GetCities() []int64 {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *Promotion) GetDeliveryTypes() []int64 {
+	if x != nil {
+		return x.DeliveryTypes
+	}
+	return nil
+}
+
+func (x *Promotion) GetRedemptions() int64 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *Promotion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetAmountMoney() *Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+func (x *Promotion) GetMaxDiscountMoney() *Money {
+	if x != nil {
+		return x.MaxDiscountMoney
+	}
+	return nil
+}
+
+type CreatePromotionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// "percent" or "flat".
+	Kind        string  `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value       float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	MaxDiscount float64 `protobuf:"fixed64,4,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	// RFC 3339 timestamps. starts_at defaults to now, and ends_at may be left
+	// empty for a promotion that does not expire.
+	StartsAt       string  `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         string  `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxRedemptions int64   `protobuf:"varint,7,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxPerMerchant int64   `protobuf:"varint,8,opt,name=max_per_merchant,json=maxPerMerchant,proto3" json:"max_per_merchant,omitempty"`
+	MerchantId     int64   `protobuf:"varint,9,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Cities         []int64 `protobuf:"varint,10,rep,packed,name=cities,proto3" json:"cities,omitempty"`
+	DeliveryTypes  []int64 `protobuf:"varint,11,rep,packed,name=delivery_types,json=deliveryTypes,proto3" json:"delivery_types,omitempty"`
+	// The amount off of a flat promotion, instead of value.
+	AmountMoney      *Money `protobuf:"bytes,12,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	MaxDiscountMoney *Money `protobuf:"bytes,13,opt,name=max_discount_money,json=maxDiscountMoney,proto3" json:"max_discount_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreatePromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetMaxRedemptions() int64 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMaxPerMerchant() int64 {
+	if x != nil {
+		return x.MaxPerMerchant
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetCities() []int64 {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetDeliveryTypes() []int64 {
+	if x != nil {
+		return x.DeliveryTypes
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetAmountMoney() *Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetMaxDiscountMoney() *Money {
+	if x != nil {
+		return x.MaxDiscountMoney
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *Promotion             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreatePromotionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreatePromotionResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreatePromotionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreatePromotionResponse) GetData() *Promotion {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{82}
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*Promotion           `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListPromotionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPromotionsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListPromotionsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPromotionsResponse) GetData() []*Promotion {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetMerchantDiscountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DiscountPercent float64                `protobuf:"fixed64,2,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetMerchantDiscountRequest) Reset() {
+	*x = SetMerchantDiscountRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMerchantDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMerchantDiscountRequest) ProtoMessage() {}
+
+func (x *SetMerchantDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMerchantDiscountRequest.ProtoReflect.Descriptor instead.
+func (*SetMerchantDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetMerchantDiscountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetMerchantDiscountRequest) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+type SetMerchantDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMerchantDiscountResponse) Reset() {
+	*x = SetMerchantDiscountResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMerchantDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMerchantDiscountResponse) ProtoMessage() {}
+
+func (x *SetMerchantDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMerchantDiscountResponse.ProtoReflect.Descriptor instead.
+func (*SetMerchantDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetMerchantDiscountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetMerchantDiscountResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SetMerchantDiscountResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// A store is a pickup point of a merchant. Orders of the store carry its name
+// and contact phone, and orders without a delivery_type are sent with
+// default_delivery_type.
+type Store struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContactPhone        string                 `protobuf:"bytes,3,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	PickupAddress       string                 `protobuf:"bytes,4,opt,name=pickup_address,json=pickupAddress,proto3" json:"pickup_address,omitempty"`
+	DefaultDeliveryType int64                  `protobuf:"varint,5,opt,name=default_delivery_type,json=defaultDeliveryType,proto3" json:"default_delivery_type,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Store) Reset() {
+	*x = Store{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Store) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store) ProtoMessage() {}
+
+func (x *Store) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store.ProtoReflect.Descriptor instead.
+func (*Store) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Store) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Store) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Store) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *Store) GetPickupAddress() string {
+	if x != nil {
+		return x.PickupAddress
+	}
+	return ""
+}
+
+func (x *Store) GetDefaultDeliveryType() int64 {
+	if x != nil {
+		return x.DefaultDeliveryType
+	}
+	return 0
+}
+
+func (x *Store) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Store) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateStoreRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContactPhone        string                 `protobuf:"bytes,2,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	PickupAddress       string                 `protobuf:"bytes,3,opt,name=pickup_address,json=pickupAddress,proto3" json:"pickup_address,omitempty"`
+	DefaultDeliveryType int64                  `protobuf:"varint,4,opt,name=default_delivery_type,json=defaultDeliveryType,proto3" json:"default_delivery_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateStoreRequest) Reset() {
+	*x = CreateStoreRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoreRequest) ProtoMessage() {}
+
+func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoreRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetPickupAddress() string {
+	if x != nil {
+		return x.PickupAddress
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetDefaultDeliveryType() int64 {
+	if x != nil {
+		return x.DefaultDeliveryType
+	}
+	return 0
+}
+
+type CreateStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *Store                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStoreResponse) Reset() {
+	*x = CreateStoreResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoreResponse) ProtoMessage() {}
+
+func (x *CreateStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoreResponse.ProtoReflect.Descriptor instead.
+func (*CreateStoreResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateStoreResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateStoreResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateStoreResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateStoreResponse) GetData() *Store {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Replaces every field of the store; orders already booked are not changed.
+type UpdateStoreRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StoreId             int64                  `protobuf:"varint,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContactPhone        string                 `protobuf:"bytes,3,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	PickupAddress       string                 `protobuf:"bytes,4,opt,name=pickup_address,json=pickupAddress,proto3" json:"pickup_address,omitempty"`
+	DefaultDeliveryType int64                  `protobuf:"varint,5,opt,name=default_delivery_type,json=defaultDeliveryType,proto3" json:"default_delivery_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateStoreRequest) Reset() {
+	*x = UpdateStoreRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStoreRequest) ProtoMessage() {}
+
+func (x *UpdateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStoreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateStoreRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *UpdateStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetPickupAddress() string {
+	if x != nil {
+		return x.PickupAddress
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetDefaultDeliveryType() int64 {
+	if x != nil {
+		return x.DefaultDeliveryType
+	}
+	return 0
+}
+
+type UpdateStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *Store                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStoreResponse) Reset() {
+	*x = UpdateStoreResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStoreResponse) ProtoMessage() {}
+
+func (x *UpdateStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStoreResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStoreResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateStoreResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateStoreResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateStoreResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateStoreResponse) GetData() *Store {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoresRequest) Reset() {
+	*x = ListStoresRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoresRequest) ProtoMessage() {}
+
+func (x *ListStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoresRequest.ProtoReflect.Descriptor instead.
+func (*ListStoresRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{91}
+}
+
+type ListStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*Store               `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoresResponse) Reset() {
+	*x = ListStoresResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoresResponse) ProtoMessage() {}
+
+func (x *ListStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoresResponse.ProtoReflect.Descriptor instead.
+func (*ListStoresResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListStoresResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListStoresResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListStoresResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListStoresResponse) GetData() []*Store {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Cities, zones and areas are the destinations orders can be sent to, for
+// recipient_city, recipient_zone and recipient_area. Orders are not taken for
+// areas that are not serviceable.
+type City struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *City) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *City) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Zone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId        int64                  `protobuf:"varint,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Zone) Reset() {
+	*x = Zone{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zone) ProtoMessage() {}
+
+func (x *Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
+func (*Zone) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Zone) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Zone) GetCityId() int64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Area struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ZoneId        int64                  `protobuf:"varint,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Serviceable   bool                   `protobuf:"varint,4,opt,name=serviceable,proto3" json:"serviceable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Area) Reset() {
+	*x = Area{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Area) ProtoMessage() {}
+
+func (x *Area) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Area.ProtoReflect.Descriptor instead.
+func (*Area) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Area) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Area) GetZoneId() int64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *Area) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Area) GetServiceable() bool {
+	if x != nil {
+		return x.Serviceable
+	}
+	return false
+}
+
+type ListCitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitiesRequest) Reset() {
+	*x = ListCitiesRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitiesRequest) ProtoMessage() {}
+
+func (x *ListCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{96}
+}
+
+type ListCitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*City                `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitiesResponse) Reset() {
+	*x = ListCitiesResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitiesResponse) ProtoMessage() {}
+
+func (x *ListCitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCitiesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListCitiesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCitiesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListCitiesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListCitiesResponse) GetData() []*City {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListZonesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        int64                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZonesRequest) Reset() {
+	*x = ListZonesRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZonesRequest) ProtoMessage() {}
+
+func (x *ListZonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZonesRequest.ProtoReflect.Descriptor instead.
+func (*ListZonesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListZonesRequest) GetCityId() int64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+type ListZonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*Zone                `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZonesResponse) Reset() {
+	*x = ListZonesResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZonesResponse) ProtoMessage() {}
+
+func (x *ListZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZonesResponse.ProtoReflect.Descriptor instead.
+func (*ListZonesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListZonesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListZonesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListZonesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListZonesResponse) GetData() []*Zone {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAreasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        int64                  `protobuf:"varint,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAreasRequest) Reset() {
+	*x = ListAreasRequest{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAreasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAreasRequest) ProtoMessage() {}
+
+func (x *ListAreasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAreasRequest.ProtoReflect.Descriptor instead.
+func (*ListAreasRequest) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListAreasRequest) GetZoneId() int64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+type ListAreasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Data          []*Area                `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAreasResponse) Reset() {
+	*x = ListAreasResponse{}
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAreasResponse) ProtoMessage() {}
+
+func (x *ListAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_adapters_grpc_proto_order_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAreasResponse.ProtoReflect.Descriptor instead.
+func (*ListAreasResponse) Descriptor() ([]byte, []int) {
+	return file_internal_adapters_grpc_proto_order_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListAreasResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAreasResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListAreasResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListAreasResponse) GetData() []*Area {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_internal_adapters_grpc_proto_order_proto protoreflect.FileDescriptor
+
+const file_internal_adapters_grpc_proto_order_proto_rawDesc = "" +
+	"\n" +
+	"(internal/adapters/grpc/proto/order.proto\x12\x05order\"M\n" +
+	"\x05Money\x12\x1f\n" +
+	"\vminor_units\x18\x01 \x01(\x03R\n" +
+	"minorUnits\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"G\n" +
+	"\rSignupRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"R\n" +
+	"\x0eSignupResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"F\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x80\x02\n" +
+	"\rLoginResponse\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x01 \x01(\tR\ttokenType\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\a \x01(\x05R\x04code\x12'\n" +
+	"\x0fchallenge_token\x18\b \x01(\tR\x0echallengeToken\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xde\x01\n" +
+	"\x14RefreshTokenResponse\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x01 \x01(\tR\ttokenType\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\a \x01(\x05R\x04code\"\xc1\x05\n" +
+	"\x12CreateOrderRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\x03R\astoreId\x12*\n" +
+	"\x11merchant_order_id\x18\x02 \x01(\tR\x0fmerchantOrderId\x12%\n" +
+	"\x0erecipient_name\x18\x03 \x01(\tR\rrecipientName\x12'\n" +
+	"\x0frecipient_phone\x18\x04 \x01(\tR\x0erecipientPhone\x12+\n" +
+	"\x11recipient_address\x18\x05 \x01(\tR\x10recipientAddress\x12%\n" +
+	"\x0erecipient_city\x18\x06 \x01(\x03R\rrecipientCity\x12%\n" +
+	"\x0erecipient_zone\x18\a \x01(\x03R\rrecipientZone\x12%\n" +
+	"\x0erecipient_area\x18\b \x01(\x03R\rrecipientArea\x12#\n" +
+	"\rdelivery_type\x18\t \x01(\x03R\fdeliveryType\x12\x1b\n" +
+	"\titem_type\x18\n" +
+	" \x01(\x03R\bitemType\x12/\n" +
+	"\x13special_instruction\x18\v \x01(\tR\x12specialInstruction\x12#\n" +
+	"\ritem_quantity\x18\f \x01(\x03R\fitemQuantity\x12\x1f\n" +
+	"\vitem_weight\x18\r \x01(\x01R\n" +
+	"itemWeight\x12*\n" +
+	"\x11amount_to_collect\x18\x0e \x01(\x01R\x0famountToCollect\x12)\n" +
+	"\x10item_description\x18\x0f \x01(\tR\x0fitemDescription\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x10 \x01(\tR\tpromoCode\x12C\n" +
+	"\x17amount_to_collect_money\x18\x11 \x01(\v2\f.order.MoneyR\x14amountToCollectMoney\"}\n" +
+	"\x13CreateOrderResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12$\n" +
+	"\x04data\x18\x04 \x01(\v2\x10.order.OrderDataR\x04data\"\xe0\x01\n" +
+	"\tOrderData\x12%\n" +
+	"\x0econsignment_id\x18\x01 \x01(\tR\rconsignmentId\x12*\n" +
+	"\x11merchant_order_id\x18\x02 \x01(\tR\x0fmerchantOrderId\x12!\n" +
+	"\forder_status\x18\x03 \x01(\tR\vorderStatus\x12!\n" +
+	"\fdelivery_fee\x18\x04 \x01(\x01R\vdeliveryFee\x12:\n" +
+	"\x12delivery_fee_money\x18\x05 \x01(\v2\f.order.MoneyR\x10deliveryFeeMoney\"\xf7\x03\n" +
+	"\x11ListOrdersRequest\x12'\n" +
+	"\x0ftransfer_status\x18\x01 \x01(\x03R\x0etransferStatus\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\x03R\aarchive\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x03R\x04page\x12\x16\n" +
+	"\x06status\x18\x05 \x03(\tR\x06status\x12!\n" +
+	"\fcreated_from\x18\x06 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\a \x01(\tR\tcreatedTo\x12%\n" +
+	"\x0erecipient_city\x18\b \x01(\x03R\rrecipientCity\x12%\n" +
+	"\x0erecipient_zone\x18\t \x01(\x03R\rrecipientZone\x12\x19\n" +
+	"\bstore_id\x18\n" +
+	" \x01(\x03R\astoreId\x12*\n" +
+	"\x11merchant_order_id\x18\v \x01(\tR\x0fmerchantOrderId\x12\x16\n" +
+	"\x06search\x18\f \x01(\tR\x06search\x12\x12\n" +
+	"\x04sort\x18\r \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x0e \x01(\tR\tpageToken\x12#\n" +
+	"\rinclude_total\x18\x0f \x01(\bR\fincludeTotal\x12\x16\n" +
+	"\x06cursor\x18\x10 \x01(\bR\x06cursor\"}\n" +
+	"\x12ListOrdersResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12%\n" +
+	"\x04data\x18\x04 \x01(\v2\x11.order.OrdersDataR\x04data\"\xef\x01\n" +
+	"\n" +
+	"OrdersData\x12$\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12!\n" +
+	"\fcurrent_page\x18\x03 \x01(\x03R\vcurrentPage\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x03R\aperPage\x12\"\n" +
+	"\rtotal_in_page\x18\x05 \x01(\x03R\vtotalInPage\x12\x1b\n" +
+	"\tlast_page\x18\x06 \x01(\x03R\blastPage\x12&\n" +
+	"\x0fnext_page_token\x18\a \x01(\tR\rnextPageToken\"\xdb\r\n" +
+	"\x05Order\x120\n" +
+	"\x14order_consignment_id\x18\x01 \x01(\tR\x12orderConsignmentId\x12(\n" +
+	"\x10order_created_at\x18\x02 \x01(\tR\x0eorderCreatedAt\x12+\n" +
+	"\x11order_description\x18\x03 \x01(\tR\x10orderDescription\x12*\n" +
+	"\x11merchant_order_id\x18\x04 \x01(\tR\x0fmerchantOrderId\x12%\n" +
+	"\x0erecipient_name\x18\x05 \x01(\tR\rrecipientName\x12+\n" +
+	"\x11recipient_address\x18\x06 \x01(\tR\x10recipientAddress\x12'\n" +
+	"\x0frecipient_phone\x18\a \x01(\tR\x0erecipientPhone\x12!\n" +
+	"\forder_amount\x18\b \x01(\x01R\vorderAmount\x12\x1b\n" +
+	"\ttotal_fee\x18\t \x01(\x01R\btotalFee\x12 \n" +
+	"\vinstruction\x18\n" +
+	" \x01(\tR\vinstruction\x12\"\n" +
+	"\rorder_type_id\x18\v \x01(\x03R\vorderTypeId\x12\x17\n" +
+	"\acod_fee\x18\f \x01(\x01R\x06codFee\x12%\n" +
+	"\x0epromo_discount\x18\r \x01(\x01R\rpromoDiscount\x12\x1a\n" +
+	"\bdiscount\x18\x0e \x01(\x01R\bdiscount\x12!\n" +
+	"\fdelivery_fee\x18\x0f \x01(\x01R\vdeliveryFee\x12!\n" +
+	"\forder_status\x18\x10 \x01(\tR\vorderStatus\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x11 \x01(\tR\torderType\x12\x1b\n" +
 	"\titem_type\x18\x12 \x01(\x03R\bitemType\x12\x1d\n" +
 	"\n" +
 	"store_name\x18\x13 \x01(\tR\tstoreName\x12.\n" +
@@ -1373,7 +7485,21 @@ const file_internal_adapters_grpc_proto_order_proto_rawDesc = "" +
 	"\ritem_quantity\x18\x1c \x01(\x03R\fitemQuantity\x12\x1f\n" +
 	"\vitem_weight\x18\x1d \x01(\x01R\n" +
 	"itemWeight\x12*\n" +
-	"\x11amount_to_collect\x18\x1e \x01(\x01R\x0famountToCollect\";\n" +
+	"\x11amount_to_collect\x18\x1e \x01(\x01R\x0famountToCollect\x12\x1f\n" +
+	"\varchived_at\x18\x1f \x01(\tR\n" +
+	"archivedAt\x12*\n" +
+	"\x11rate_card_version\x18  \x01(\x03R\x0frateCardVersion\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18! \x01(\tR\tpromoCode\x12:\n" +
+	"\x12order_amount_money\x18\" \x01(\v2\f.order.MoneyR\x10orderAmountMoney\x124\n" +
+	"\x0ftotal_fee_money\x18# \x01(\v2\f.order.MoneyR\rtotalFeeMoney\x120\n" +
+	"\rcod_fee_money\x18$ \x01(\v2\f.order.MoneyR\vcodFeeMoney\x12>\n" +
+	"\x14promo_discount_money\x18% \x01(\v2\f.order.MoneyR\x12promoDiscountMoney\x123\n" +
+	"\x0ediscount_money\x18& \x01(\v2\f.order.MoneyR\rdiscountMoney\x12:\n" +
+	"\x12delivery_fee_money\x18' \x01(\v2\f.order.MoneyR\x10deliveryFeeMoney\x126\n" +
+	"\x10cod_amount_money\x18( \x01(\v2\f.order.MoneyR\x0ecodAmountMoney\x12@\n" +
+	"\x15delivery_charge_money\x18) \x01(\v2\f.order.MoneyR\x13deliveryChargeMoney\x12C\n" +
+	"\x17amount_to_collect_money\x18* \x01(\v2\f.order.MoneyR\x14amountToCollectMoney\";\n" +
 	"\x12CancelOrderRequest\x12%\n" +
 	"\x0econsignment_id\x18\x01 \x01(\tR\rconsignmentId\"W\n" +
 	"\x13CancelOrderResponse\x12\x18\n" +
@@ -1384,7 +7510,446 @@ const file_internal_adapters_grpc_proto_order_proto_rawDesc = "" +
 	"\x0eLogoutResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\x05R\x04code2\xff\x02\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"G\n" +
+	"\x16UpdateUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"[\n" +
+	"\x17UpdateUserRolesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"\xd9\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x19\n" +
+	"\bstore_id\x18\x04 \x01(\x03R\astoreId\x12\x18\n" +
+	"\amethods\x18\x05 \x03(\tR\amethods\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\"^\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\x03R\astoreId\x12\x18\n" +
+	"\amethods\x18\x03 \x03(\tR\amethods\"\x8d\x01\n" +
+	"\x14CreateApiKeyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12!\n" +
+	"\x04data\x18\x04 \x01(\v2\r.order.ApiKeyR\x04data\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\"\x14\n" +
+	"\x12ListApiKeysRequest\"z\n" +
+	"\x13ListApiKeysResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12!\n" +
+	"\x04data\x18\x04 \x03(\v2\r.order.ApiKeyR\x04data\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"X\n" +
+	"\x14RevokeApiKeyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"2\n" +
+	"\x14UnlockAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"Y\n" +
+	"\x15UnlockAccountResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"Z\n" +
+	"\x16ChangePasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"9\n" +
+	"\x1bRequestPasswordResetRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"`\n" +
+	"\x1cRequestPasswordResetResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"O\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"Y\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"(\n" +
+	"\x12VerifyEmailRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"W\n" +
+	"\x13VerifyEmailResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"\x1d\n" +
+	"\x1bSendVerificationCodeRequest\"`\n" +
+	"\x1cSendVerificationCodeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"\xbb\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_seen_at\x18\x06 \x01(\tR\n" +
+	"lastSeenAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"\x15\n" +
+	"\x13ListSessionsRequest\"|\n" +
+	"\x14ListSessionsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\"\n" +
+	"\x04data\x18\x04 \x03(\v2\x0e.order.SessionR\x04data\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"Y\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"\x18\n" +
+	"\x16EnrollTwoFactorRequest\"\x9e\x01\n" +
+	"\x17EnrollTwoFactorResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12)\n" +
+	"\x10provisioning_uri\x18\x05 \x01(\tR\x0fprovisioningUri\"-\n" +
+	"\x17ConfirmTwoFactorRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x83\x01\n" +
+	"\x18ConfirmTwoFactorResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12%\n" +
+	"\x0erecovery_codes\x18\x04 \x03(\tR\rrecoveryCodes\"U\n" +
+	"\x16VerifyTwoFactorRequest\x12'\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tR\x0echallengeToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xe1\x01\n" +
+	"\x17VerifyTwoFactorResponse\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x01 \x01(\tR\ttokenType\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\a \x01(\x05R\x04code\"-\n" +
+	"\x17DisableTwoFactorRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\\\n" +
+	"\x18DisableTwoFactorResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"4\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x8a\x01\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12%\n" +
+	"\x0erecovery_codes\x18\x04 \x03(\tR\rrecoveryCodes\"q\n" +
+	"\x18UpdateOrderStatusRequest\x12%\n" +
+	"\x0econsignment_id\x18\x01 \x01(\tR\rconsignmentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x83\x01\n" +
+	"\x19UpdateOrderStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12$\n" +
+	"\x04data\x18\x04 \x01(\v2\x10.order.OrderDataR\x04data\"\xd5\x01\n" +
+	"\x10OrderStatusEvent\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x121\n" +
+	"\achanges\x18\x06 \x03(\v2\x17.order.OrderFieldChangeR\achanges\"L\n" +
+	"\x10OrderFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x82\x05\n" +
+	"\x12UpdateOrderRequest\x12%\n" +
+	"\x0econsignment_id\x18\x01 \x01(\tR\rconsignmentId\x12%\n" +
+	"\x0erecipient_name\x18\x02 \x01(\tR\rrecipientName\x12'\n" +
+	"\x0frecipient_phone\x18\x03 \x01(\tR\x0erecipientPhone\x12+\n" +
+	"\x11recipient_address\x18\x04 \x01(\tR\x10recipientAddress\x12%\n" +
+	"\x0erecipient_city\x18\x05 \x01(\x03R\rrecipientCity\x12%\n" +
+	"\x0erecipient_zone\x18\x06 \x01(\x03R\rrecipientZone\x12%\n" +
+	"\x0erecipient_area\x18\a \x01(\x03R\rrecipientArea\x12#\n" +
+	"\rdelivery_type\x18\b \x01(\x03R\fdeliveryType\x12\x1b\n" +
+	"\titem_type\x18\t \x01(\x03R\bitemType\x12/\n" +
+	"\x13special_instruction\x18\n" +
+	" \x01(\tR\x12specialInstruction\x12#\n" +
+	"\ritem_quantity\x18\v \x01(\x03R\fitemQuantity\x12\x1f\n" +
+	"\vitem_weight\x18\f \x01(\x01R\n" +
+	"itemWeight\x12*\n" +
+	"\x11amount_to_collect\x18\r \x01(\x01R\x0famountToCollect\x12)\n" +
+	"\x10item_description\x18\x0e \x01(\tR\x0fitemDescription\x12C\n" +
+	"\x17amount_to_collect_money\x18\x0f \x01(\v2\f.order.MoneyR\x14amountToCollectMoney\"y\n" +
+	"\x13UpdateOrderResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12 \n" +
+	"\x04data\x18\x04 \x01(\v2\f.order.OrderR\x04data\"8\n" +
+	"\x0fGetOrderRequest\x12%\n" +
+	"\x0econsignment_id\x18\x01 \x01(\tR\rconsignmentId\"\xa9\x01\n" +
+	"\x10GetOrderResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12 \n" +
+	"\x04data\x18\x04 \x01(\v2\f.order.OrderR\x04data\x121\n" +
+	"\ahistory\x18\x05 \x03(\v2\x17.order.OrderStatusEventR\ahistory\"?\n" +
+	"\x14ArchiveOrdersRequest\x12'\n" +
+	"\x0fconsignment_ids\x18\x01 \x03(\tR\x0econsignmentIds\"\x82\x01\n" +
+	"\x15ArchiveOrdersResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12'\n" +
+	"\x0fconsignment_ids\x18\x04 \x03(\tR\x0econsignmentIds\"A\n" +
+	"\x16UnarchiveOrdersRequest\x12'\n" +
+	"\x0fconsignment_ids\x18\x01 \x03(\tR\x0econsignmentIds\"\x84\x01\n" +
+	"\x17UnarchiveOrdersResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12'\n" +
+	"\x0fconsignment_ids\x18\x04 \x03(\tR\x0econsignmentIds\"\x8e\x02\n" +
+	"\x0fBulkOrderResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12%\n" +
+	"\x0econsignment_id\x18\x02 \x01(\tR\rconsignmentId\x12*\n" +
+	"\x11merchant_order_id\x18\x03 \x01(\tR\x0fmerchantOrderId\x12!\n" +
+	"\forder_status\x18\x04 \x01(\tR\vorderStatus\x12!\n" +
+	"\fdelivery_fee\x18\x05 \x01(\x01R\vdeliveryFee\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12:\n" +
+	"\x12delivery_fee_money\x18\a \x01(\v2\f.order.MoneyR\x10deliveryFeeMoney\"\xc0\x01\n" +
+	"\x18BulkCreateOrdersResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\x03R\acreated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x03R\x06failed\x120\n" +
+	"\aresults\x18\x06 \x03(\v2\x16.order.BulkOrderResultR\aresults\"*\n" +
+	"\x16ImportOrdersCsvRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\"\x80\x01\n" +
+	"\n" +
+	"WeightBand\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x01 \x01(\x01R\tmaxWeight\x12\x1c\n" +
+	"\tsurcharge\x18\x02 \x01(\x01R\tsurcharge\x125\n" +
+	"\x0fsurcharge_money\x18\x03 \x01(\v2\f.order.MoneyR\x0esurchargeMoney\"\xcd\x03\n" +
+	"\x04Rate\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\x03R\x04city\x12\x12\n" +
+	"\x04zone\x18\x02 \x01(\x03R\x04zone\x12\x12\n" +
+	"\x04area\x18\x03 \x01(\x03R\x04area\x12#\n" +
+	"\rdelivery_type\x18\x04 \x01(\x03R\fdeliveryType\x12\x1b\n" +
+	"\titem_type\x18\x05 \x01(\x03R\bitemType\x12\x19\n" +
+	"\bbase_fee\x18\x06 \x01(\x01R\abaseFee\x124\n" +
+	"\fweight_bands\x18\a \x03(\v2\x11.order.WeightBandR\vweightBands\x12 \n" +
+	"\fextra_kg_fee\x18\b \x01(\x01R\n" +
+	"extraKgFee\x12%\n" +
+	"\x0eoverweight_fee\x18\t \x01(\x01R\roverweightFee\x122\n" +
+	"\x0ebase_fee_money\x18\n" +
+	" \x01(\v2\f.order.MoneyR\fbaseFeeMoney\x129\n" +
+	"\x12extra_kg_fee_money\x18\v \x01(\v2\f.order.MoneyR\x0fextraKgFeeMoney\x12>\n" +
+	"\x14overweight_fee_money\x18\f \x01(\v2\f.order.MoneyR\x12overweightFeeMoney\"\xf4\x02\n" +
+	"\bRateCard\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12%\n" +
+	"\x0eeffective_from\x18\x02 \x01(\tR\reffectiveFrom\x12\x1f\n" +
+	"\vcod_percent\x18\x03 \x01(\x01R\n" +
+	"codPercent\x12\x1e\n" +
+	"\vmin_cod_fee\x18\x04 \x01(\x01R\tminCodFee\x12(\n" +
+	"\x10min_delivery_fee\x18\x05 \x01(\x01R\x0eminDeliveryFee\x12!\n" +
+	"\x05rates\x18\x06 \x03(\v2\v.order.RateR\x05rates\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x127\n" +
+	"\x11min_cod_fee_money\x18\b \x01(\v2\f.order.MoneyR\x0eminCodFeeMoney\x12A\n" +
+	"\x16min_delivery_fee_money\x18\t \x01(\v2\f.order.MoneyR\x13minDeliveryFeeMoney\"\xc8\x02\n" +
+	"\x15CreateRateCardRequest\x12%\n" +
+	"\x0eeffective_from\x18\x01 \x01(\tR\reffectiveFrom\x12\x1f\n" +
+	"\vcod_percent\x18\x02 \x01(\x01R\n" +
+	"codPercent\x12\x1e\n" +
+	"\vmin_cod_fee\x18\x03 \x01(\x01R\tminCodFee\x12(\n" +
+	"\x10min_delivery_fee\x18\x04 \x01(\x01R\x0eminDeliveryFee\x12!\n" +
+	"\x05rates\x18\x05 \x03(\v2\v.order.RateR\x05rates\x127\n" +
+	"\x11min_cod_fee_money\x18\x06 \x01(\v2\f.order.MoneyR\x0eminCodFeeMoney\x12A\n" +
+	"\x16min_delivery_fee_money\x18\a \x01(\v2\f.order.MoneyR\x13minDeliveryFeeMoney\"\x7f\n" +
+	"\x16CreateRateCardResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12#\n" +
+	"\x04data\x18\x04 \x01(\v2\x0f.order.RateCardR\x04data\"\x16\n" +
+	"\x14ListRateCardsRequest\"~\n" +
+	"\x15ListRateCardsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12#\n" +
+	"\x04data\x18\x04 \x03(\v2\x0f.order.RateCardR\x04data\"\xc1\x03\n" +
+	"\x17QuoteDeliveryFeeRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\x03R\astoreId\x12%\n" +
+	"\x0erecipient_city\x18\x02 \x01(\x03R\rrecipientCity\x12%\n" +
+	"\x0erecipient_zone\x18\x03 \x01(\x03R\rrecipientZone\x12%\n" +
+	"\x0erecipient_area\x18\x04 \x01(\x03R\rrecipientArea\x12#\n" +
+	"\rdelivery_type\x18\x05 \x01(\x03R\fdeliveryType\x12\x1b\n" +
+	"\titem_type\x18\x06 \x01(\x03R\bitemType\x12#\n" +
+	"\ritem_quantity\x18\a \x01(\x03R\fitemQuantity\x12\x1f\n" +
+	"\vitem_weight\x18\b \x01(\x01R\n" +
+	"itemWeight\x12*\n" +
+	"\x11amount_to_collect\x18\t \x01(\x01R\x0famountToCollect\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\n" +
+	" \x01(\tR\tpromoCode\x12C\n" +
+	"\x17amount_to_collect_money\x18\v \x01(\v2\f.order.MoneyR\x14amountToCollectMoney\"\xa9\x05\n" +
+	"\bFeeQuote\x12\x19\n" +
+	"\bbase_fee\x18\x01 \x01(\x01R\abaseFee\x12)\n" +
+	"\x10weight_surcharge\x18\x02 \x01(\x01R\x0fweightSurcharge\x12!\n" +
+	"\fdelivery_fee\x18\x03 \x01(\x01R\vdeliveryFee\x12\x17\n" +
+	"\acod_fee\x18\x04 \x01(\x01R\x06codFee\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x01R\bdiscount\x12\x1b\n" +
+	"\ttotal_fee\x18\x06 \x01(\x01R\btotalFee\x12*\n" +
+	"\x11rate_card_version\x18\a \x01(\x03R\x0frateCardVersion\x12%\n" +
+	"\x0epromo_discount\x18\b \x01(\x01R\rpromoDiscount\x122\n" +
+	"\x0ebase_fee_money\x18\t \x01(\v2\f.order.MoneyR\fbaseFeeMoney\x12B\n" +
+	"\x16weight_surcharge_money\x18\n" +
+	" \x01(\v2\f.order.MoneyR\x14weightSurchargeMoney\x12:\n" +
+	"\x12delivery_fee_money\x18\v \x01(\v2\f.order.MoneyR\x10deliveryFeeMoney\x120\n" +
+	"\rcod_fee_money\x18\f \x01(\v2\f.order.MoneyR\vcodFeeMoney\x123\n" +
+	"\x0ediscount_money\x18\r \x01(\v2\f.order.MoneyR\rdiscountMoney\x124\n" +
+	"\x0ftotal_fee_money\x18\x0e \x01(\v2\f.order.MoneyR\rtotalFeeMoney\x12>\n" +
+	"\x14promo_discount_money\x18\x0f \x01(\v2\f.order.MoneyR\x12promoDiscountMoney\"\x81\x01\n" +
+	"\x18QuoteDeliveryFeeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12#\n" +
+	"\x04data\x18\x04 \x01(\v2\x0f.order.FeeQuoteR\x04data\"\x93\x04\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12!\n" +
+	"\fmax_discount\x18\x05 \x01(\x01R\vmaxDiscount\x12\x1b\n" +
+	"\tstarts_at\x18\x06 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\a \x01(\tR\x06endsAt\x12'\n" +
+	"\x0fmax_redemptions\x18\b \x01(\x03R\x0emaxRedemptions\x12(\n" +
+	"\x10max_per_merchant\x18\t \x01(\x03R\x0emaxPerMerchant\x12\x1f\n" +
+	"\vmerchant_id\x18\n" +
+	" \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06cities\x18\v \x03(\x03R\x06cities\x12%\n" +
+	"\x0edelivery_types\x18\f \x03(\x03R\rdeliveryTypes\x12 \n" +
+	"\vredemptions\x18\r \x01(\x03R\vredemptions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12/\n" +
+	"\famount_money\x18\x0f \x01(\v2\f.order.MoneyR\vamountMoney\x12:\n" +
+	"\x12max_discount_money\x18\x10 \x01(\v2\f.order.MoneyR\x10maxDiscountMoney\"\xcf\x03\n" +
+	"\x16CreatePromotionRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12!\n" +
+	"\fmax_discount\x18\x04 \x01(\x01R\vmaxDiscount\x12\x1b\n" +
+	"\tstarts_at\x18\x05 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x06 \x01(\tR\x06endsAt\x12'\n" +
+	"\x0fmax_redemptions\x18\a \x01(\x03R\x0emaxRedemptions\x12(\n" +
+	"\x10max_per_merchant\x18\b \x01(\x03R\x0emaxPerMerchant\x12\x1f\n" +
+	"\vmerchant_id\x18\t \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06cities\x18\n" +
+	" \x03(\x03R\x06cities\x12%\n" +
+	"\x0edelivery_types\x18\v \x03(\x03R\rdeliveryTypes\x12/\n" +
+	"\famount_money\x18\f \x01(\v2\f.order.MoneyR\vamountMoney\x12:\n" +
+	"\x12max_discount_money\x18\r \x01(\v2\f.order.MoneyR\x10maxDiscountMoney\"\x81\x01\n" +
+	"\x17CreatePromotionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12$\n" +
+	"\x04data\x18\x04 \x01(\v2\x10.order.PromotionR\x04data\"\x17\n" +
+	"\x15ListPromotionsRequest\"\x80\x01\n" +
+	"\x16ListPromotionsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12$\n" +
+	"\x04data\x18\x04 \x03(\v2\x10.order.PromotionR\x04data\"`\n" +
+	"\x1aSetMerchantDiscountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10discount_percent\x18\x02 \x01(\x01R\x0fdiscountPercent\"_\n" +
+	"\x1bSetMerchantDiscountResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"\xe9\x01\n" +
+	"\x05Store\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rcontact_phone\x18\x03 \x01(\tR\fcontactPhone\x12%\n" +
+	"\x0epickup_address\x18\x04 \x01(\tR\rpickupAddress\x122\n" +
+	"\x15default_delivery_type\x18\x05 \x01(\x03R\x13defaultDeliveryType\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xa8\x01\n" +
+	"\x12CreateStoreRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rcontact_phone\x18\x02 \x01(\tR\fcontactPhone\x12%\n" +
+	"\x0epickup_address\x18\x03 \x01(\tR\rpickupAddress\x122\n" +
+	"\x15default_delivery_type\x18\x04 \x01(\x03R\x13defaultDeliveryType\"y\n" +
+	"\x13CreateStoreResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12 \n" +
+	"\x04data\x18\x04 \x01(\v2\f.order.StoreR\x04data\"\xc3\x01\n" +
+	"\x12UpdateStoreRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\x03R\astoreId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rcontact_phone\x18\x03 \x01(\tR\fcontactPhone\x12%\n" +
+	"\x0epickup_address\x18\x04 \x01(\tR\rpickupAddress\x122\n" +
+	"\x15default_delivery_type\x18\x05 \x01(\x03R\x13defaultDeliveryType\"y\n" +
+	"\x13UpdateStoreResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12 \n" +
+	"\x04data\x18\x04 \x01(\v2\f.order.StoreR\x04data\"\x13\n" +
+	"\x11ListStoresRequest\"x\n" +
+	"\x12ListStoresResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12 \n" +
+	"\x04data\x18\x04 \x03(\v2\f.order.StoreR\x04data\"*\n" +
+	"\x04City\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"C\n" +
+	"\x04Zone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\x03R\x06cityId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"e\n" +
+	"\x04Area\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\azone_id\x18\x02 \x01(\x03R\x06zoneId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vserviceable\x18\x04 \x01(\bR\vserviceable\"\x13\n" +
+	"\x11ListCitiesRequest\"w\n" +
+	"\x12ListCitiesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x1f\n" +
+	"\x04data\x18\x04 \x03(\v2\v.order.CityR\x04data\"+\n" +
+	"\x10ListZonesRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x03R\x06cityId\"v\n" +
+	"\x11ListZonesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x1f\n" +
+	"\x04data\x18\x04 \x03(\v2\v.order.ZoneR\x04data\"+\n" +
+	"\x10ListAreasRequest\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\x03R\x06zoneId\"v\n" +
+	"\x11ListAreasResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x1f\n" +
+	"\x04data\x18\x04 \x03(\v2\v.order.AreaR\x04data2\xcd\x19\n" +
 	"\fOrderService\x125\n" +
 	"\x06Signup\x12\x14.order.SignupRequest\x1a\x15.order.SignupResponse\x122\n" +
 	"\x05Login\x12\x13.order.LoginRequest\x1a\x14.order.LoginResponse\x12D\n" +
@@ -1392,7 +7957,46 @@ const file_internal_adapters_grpc_proto_order_proto_rawDesc = "" +
 	"\n" +
 	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12D\n" +
 	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x125\n" +
-	"\x06Logout\x12\x14.order.LogoutRequest\x1a\x15.order.LogoutResponseB\x1eZ\x1cinternal/adapters/grpc/protob\x06proto3"
+	"\x06Logout\x12\x14.order.LogoutRequest\x1a\x15.order.LogoutResponse\x12G\n" +
+	"\fRefreshToken\x12\x1a.order.RefreshTokenRequest\x1a\x1b.order.RefreshTokenResponse\x12P\n" +
+	"\x0fUpdateUserRoles\x12\x1d.order.UpdateUserRolesRequest\x1a\x1e.order.UpdateUserRolesResponse\x12G\n" +
+	"\fCreateApiKey\x12\x1a.order.CreateApiKeyRequest\x1a\x1b.order.CreateApiKeyResponse\x12D\n" +
+	"\vListApiKeys\x12\x19.order.ListApiKeysRequest\x1a\x1a.order.ListApiKeysResponse\x12G\n" +
+	"\fRevokeApiKey\x12\x1a.order.RevokeApiKeyRequest\x1a\x1b.order.RevokeApiKeyResponse\x12J\n" +
+	"\rUnlockAccount\x12\x1b.order.UnlockAccountRequest\x1a\x1c.order.UnlockAccountResponse\x12M\n" +
+	"\x0eChangePassword\x12\x1c.order.ChangePasswordRequest\x1a\x1d.order.ChangePasswordResponse\x12_\n" +
+	"\x14RequestPasswordReset\x12\".order.RequestPasswordResetRequest\x1a#.order.RequestPasswordResetResponse\x12J\n" +
+	"\rResetPassword\x12\x1b.order.ResetPasswordRequest\x1a\x1c.order.ResetPasswordResponse\x12D\n" +
+	"\vVerifyEmail\x12\x19.order.VerifyEmailRequest\x1a\x1a.order.VerifyEmailResponse\x12_\n" +
+	"\x14SendVerificationCode\x12\".order.SendVerificationCodeRequest\x1a#.order.SendVerificationCodeResponse\x12G\n" +
+	"\fListSessions\x12\x1a.order.ListSessionsRequest\x1a\x1b.order.ListSessionsResponse\x12J\n" +
+	"\rRevokeSession\x12\x1b.order.RevokeSessionRequest\x1a\x1c.order.RevokeSessionResponse\x12P\n" +
+	"\x0fEnrollTwoFactor\x12\x1d.order.EnrollTwoFactorRequest\x1a\x1e.order.EnrollTwoFactorResponse\x12S\n" +
+	"\x10ConfirmTwoFactor\x12\x1e.order.ConfirmTwoFactorRequest\x1a\x1f.order.ConfirmTwoFactorResponse\x12P\n" +
+	"\x0fVerifyTwoFactor\x12\x1d.order.VerifyTwoFactorRequest\x1a\x1e.order.VerifyTwoFactorResponse\x12S\n" +
+	"\x10DisableTwoFactor\x12\x1e.order.DisableTwoFactorRequest\x1a\x1f.order.DisableTwoFactorResponse\x12h\n" +
+	"\x17RegenerateRecoveryCodes\x12%.order.RegenerateRecoveryCodesRequest\x1a&.order.RegenerateRecoveryCodesResponse\x12V\n" +
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12D\n" +
+	"\vUpdateOrder\x12\x19.order.UpdateOrderRequest\x1a\x1a.order.UpdateOrderResponse\x12;\n" +
+	"\bGetOrder\x12\x16.order.GetOrderRequest\x1a\x17.order.GetOrderResponse\x12J\n" +
+	"\rArchiveOrders\x12\x1b.order.ArchiveOrdersRequest\x1a\x1c.order.ArchiveOrdersResponse\x12P\n" +
+	"\x0fUnarchiveOrders\x12\x1d.order.UnarchiveOrdersRequest\x1a\x1e.order.UnarchiveOrdersResponse\x12P\n" +
+	"\x10BulkCreateOrders\x12\x19.order.CreateOrderRequest\x1a\x1f.order.BulkCreateOrdersResponse(\x01\x12Q\n" +
+	"\x0fImportOrdersCsv\x12\x1d.order.ImportOrdersCsvRequest\x1a\x1f.order.BulkCreateOrdersResponse\x12M\n" +
+	"\x0eCreateRateCard\x12\x1c.order.CreateRateCardRequest\x1a\x1d.order.CreateRateCardResponse\x12J\n" +
+	"\rListRateCards\x12\x1b.order.ListRateCardsRequest\x1a\x1c.order.ListRateCardsResponse\x12S\n" +
+	"\x10QuoteDeliveryFee\x12\x1e.order.QuoteDeliveryFeeRequest\x1a\x1f.order.QuoteDeliveryFeeResponse\x12P\n" +
+	"\x0fCreatePromotion\x12\x1d.order.CreatePromotionRequest\x1a\x1e.order.CreatePromotionResponse\x12M\n" +
+	"\x0eListPromotions\x12\x1c.order.ListPromotionsRequest\x1a\x1d.order.ListPromotionsResponse\x12\\\n" +
+	"\x13SetMerchantDiscount\x12!.order.SetMerchantDiscountRequest\x1a\".order.SetMerchantDiscountResponse\x12D\n" +
+	"\vCreateStore\x12\x19.order.CreateStoreRequest\x1a\x1a.order.CreateStoreResponse\x12D\n" +
+	"\vUpdateStore\x12\x19.order.UpdateStoreRequest\x1a\x1a.order.UpdateStoreResponse\x12A\n" +
+	"\n" +
+	"ListStores\x12\x18.order.ListStoresRequest\x1a\x19.order.ListStoresResponse\x12A\n" +
+	"\n" +
+	"ListCities\x12\x18.order.ListCitiesRequest\x1a\x19.order.ListCitiesResponse\x12>\n" +
+	"\tListZones\x12\x17.order.ListZonesRequest\x1a\x18.order.ListZonesResponse\x12>\n" +
+	"\tListAreas\x12\x17.order.ListAreasRequest\x1a\x18.order.ListAreasResponseB\x1eZ\x1cinternal/adapters/grpc/protob\x06proto3"
 
 var (
 	file_internal_adapters_grpc_proto_order_proto_rawDescOnce sync.Once
@@ -1406,45 +8010,262 @@ func file_internal_adapters_grpc_proto_order_proto_rawDescGZIP() []byte {
 	return file_internal_adapters_grpc_proto_order_proto_rawDescData
 }
 
-var file_internal_adapters_grpc_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_internal_adapters_grpc_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
 var file_internal_adapters_grpc_proto_order_proto_goTypes = []any{
-	(*SignupRequest)(nil),       // 0: order.SignupRequest
-	(*SignupResponse)(nil),      // 1: order.SignupResponse
-	(*LoginRequest)(nil),        // 2: order.LoginRequest
-	(*LoginResponse)(nil),       // 3: order.LoginResponse
-	(*CreateOrderRequest)(nil),  // 4: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil), // 5: order.CreateOrderResponse
-	(*OrderData)(nil),           // 6: order.OrderData
-	(*ListOrdersRequest)(nil),   // 7: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),  // 8: order.ListOrdersResponse
-	(*OrdersData)(nil),          // 9: order.OrdersData
-	(*Order)(nil),               // 10: order.Order
-	(*CancelOrderRequest)(nil),  // 11: order.CancelOrderRequest
-	(*CancelOrderResponse)(nil), // 12: order.CancelOrderResponse
-	(*LogoutRequest)(nil),       // 13: order.LogoutRequest
-	(*LogoutResponse)(nil),      // 14: order.LogoutResponse
+	(*Money)(nil),                           // 0: order.Money
+	(*SignupRequest)(nil),                   // 1: order.SignupRequest
+	(*SignupResponse)(nil),                  // 2: order.SignupResponse
+	(*LoginRequest)(nil),                    // 3: order.LoginRequest
+	(*LoginResponse)(nil),                   // 4: order.LoginResponse
+	(*RefreshTokenRequest)(nil),             // 5: order.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),            // 6: order.RefreshTokenResponse
+	(*CreateOrderRequest)(nil),              // 7: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),             // 8: order.CreateOrderResponse
+	(*OrderData)(nil),                       // 9: order.OrderData
+	(*ListOrdersRequest)(nil),               // 10: order.ListOrdersRequest
+	(*ListOrdersResponse)(nil),              // 11: order.ListOrdersResponse
+	(*OrdersData)(nil),                      // 12: order.OrdersData
+	(*Order)(nil),                           // 13: order.Order
+	(*CancelOrderRequest)(nil),              // 14: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),             // 15: order.CancelOrderResponse
+	(*LogoutRequest)(nil),                   // 16: order.LogoutRequest
+	(*LogoutResponse)(nil),                  // 17: order.LogoutResponse
+	(*UpdateUserRolesRequest)(nil),          // 18: order.UpdateUserRolesRequest
+	(*UpdateUserRolesResponse)(nil),         // 19: order.UpdateUserRolesResponse
+	(*ApiKey)(nil),                          // 20: order.ApiKey
+	(*CreateApiKeyRequest)(nil),             // 21: order.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),            // 22: order.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),              // 23: order.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),             // 24: order.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),             // 25: order.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),            // 26: order.RevokeApiKeyResponse
+	(*UnlockAccountRequest)(nil),            // 27: order.UnlockAccountRequest
+	(*UnlockAccountResponse)(nil),           // 28: order.UnlockAccountResponse
+	(*ChangePasswordRequest)(nil),           // 29: order.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 30: order.ChangePasswordResponse
+	(*RequestPasswordResetRequest)(nil),     // 31: order.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 32: order.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 33: order.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 34: order.ResetPasswordResponse
+	(*VerifyEmailRequest)(nil),              // 35: order.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 36: order.VerifyEmailResponse
+	(*SendVerificationCodeRequest)(nil),     // 37: order.SendVerificationCodeRequest
+	(*SendVerificationCodeResponse)(nil),    // 38: order.SendVerificationCodeResponse
+	(*Session)(nil),                         // 39: order.Session
+	(*ListSessionsRequest)(nil),             // 40: order.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 41: order.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),            // 42: order.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),           // 43: order.RevokeSessionResponse
+	(*EnrollTwoFactorRequest)(nil),          // 44: order.EnrollTwoFactorRequest
+	(*EnrollTwoFactorResponse)(nil),         // 45: order.EnrollTwoFactorResponse
+	(*ConfirmTwoFactorRequest)(nil),         // 46: order.ConfirmTwoFactorRequest
+	(*ConfirmTwoFactorResponse)(nil),        // 47: order.ConfirmTwoFactorResponse
+	(*VerifyTwoFactorRequest)(nil),          // 48: order.VerifyTwoFactorRequest
+	(*VerifyTwoFactorResponse)(nil),         // 49: order.VerifyTwoFactorResponse
+	(*DisableTwoFactorRequest)(nil),         // 50: order.DisableTwoFactorRequest
+	(*DisableTwoFactorResponse)(nil),        // 51: order.DisableTwoFactorResponse
+	(*RegenerateRecoveryCodesRequest)(nil),  // 52: order.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil), // 53: order.RegenerateRecoveryCodesResponse
+	(*UpdateOrderStatusRequest)(nil),        // 54: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),       // 55: order.UpdateOrderStatusResponse
+	(*OrderStatusEvent)(nil),                // 56: order.OrderStatusEvent
+	(*OrderFieldChange)(nil),                // 57: order.OrderFieldChange
+	(*UpdateOrderRequest)(nil),              // 58: order.UpdateOrderRequest
+	(*UpdateOrderResponse)(nil),             // 59: order.UpdateOrderResponse
+	(*GetOrderRequest)(nil),                 // 60: order.GetOrderRequest
+	(*GetOrderResponse)(nil),                // 61: order.GetOrderResponse
+	(*ArchiveOrdersRequest)(nil),            // 62: order.ArchiveOrdersRequest
+	(*ArchiveOrdersResponse)(nil),           // 63: order.ArchiveOrdersResponse
+	(*UnarchiveOrdersRequest)(nil),          // 64: order.UnarchiveOrdersRequest
+	(*UnarchiveOrdersResponse)(nil),         // 65: order.UnarchiveOrdersResponse
+	(*BulkOrderResult)(nil),                 // 66: order.BulkOrderResult
+	(*BulkCreateOrdersResponse)(nil),        // 67: order.BulkCreateOrdersResponse
+	(*ImportOrdersCsvRequest)(nil),          // 68: order.ImportOrdersCsvRequest
+	(*WeightBand)(nil),                      // 69: order.WeightBand
+	(*Rate)(nil),                            // 70: order.Rate
+	(*RateCard)(nil),                        // 71: order.RateCard
+	(*CreateRateCardRequest)(nil),           // 72: order.CreateRateCardRequest
+	(*CreateRateCardResponse)(nil),          // 73: order.CreateRateCardResponse
+	(*ListRateCardsRequest)(nil),            // 74: order.ListRateCardsRequest
+	(*ListRateCardsResponse)(nil),           // 75: order.ListRateCardsResponse
+	(*QuoteDeliveryFeeRequest)(nil),         // 76: order.QuoteDeliveryFeeRequest
+	(*FeeQuote)(nil),                        // 77: order.FeeQuote
+	(*QuoteDeliveryFeeResponse)(nil),        // 78: order.QuoteDeliveryFeeResponse
+	(*Promotion)(nil),                       // 79: order.Promotion
+	(*CreatePromotionRequest)(nil),          // 80: order.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),         // 81: order.CreatePromotionResponse
+	(*ListPromotionsRequest)(nil),           // 82: order.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),          // 83: order.ListPromotionsResponse
+	(*SetMerchantDiscountRequest)(nil),      // 84: order.SetMerchantDiscountRequest
+	(*SetMerchantDiscountResponse)(nil),     // 85: order.SetMerchantDiscountResponse
+	(*Store)(nil),                           // 86: order.Store
+	(*CreateStoreRequest)(nil),              // 87: order.CreateStoreRequest
+	(*CreateStoreResponse)(nil),             // 88: order.CreateStoreResponse
+	(*UpdateStoreRequest)(nil),              // 89: order.UpdateStoreRequest
+	(*UpdateStoreResponse)(nil),             // 90: order.UpdateStoreResponse
+	(*ListStoresRequest)(nil),               // 91: order.ListStoresRequest
+	(*ListStoresResponse)(nil),              // 92: order.ListStoresResponse
+	(*City)(nil),                            // 93: order.City
+	(*Zone)(nil),                            // 94: order.Zone
+	(*Area)(nil),                            // 95: order.Area
+	(*ListCitiesRequest)(nil),               // 96: order.ListCitiesRequest
+	(*ListCitiesResponse)(nil),              // 97: order.ListCitiesResponse
+	(*ListZonesRequest)(nil),                // 98: order.ListZonesRequest
+	(*ListZonesResponse)(nil),               // 99: order.ListZonesResponse
+	(*ListAreasRequest)(nil),                // 100: order.ListAreasRequest
+	(*ListAreasResponse)(nil),               // 101: order.ListAreasResponse
 }
 var file_internal_adapters_grpc_proto_order_proto_depIdxs = []int32{
-	6,  // 0: order.CreateOrderResponse.data:type_name -> order.OrderData
-	9,  // 1: order.ListOrdersResponse.data:type_name -> order.OrdersData
-	10, // 2: order.OrdersData.orders:type_name -> order.Order
-	0,  // 3: order.OrderService.Signup:input_type -> order.SignupRequest
-	2,  // 4: order.OrderService.Login:input_type -> order.LoginRequest
-	4,  // 5: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	7,  // 6: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	11, // 7: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
-	13, // 8: order.OrderService.Logout:input_type -> order.LogoutRequest
-	1,  // 9: order.OrderService.Signup:output_type -> order.SignupResponse
-	3,  // 10: order.OrderService.Login:output_type -> order.LoginResponse
-	5,  // 11: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	8,  // 12: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	12, // 13: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
-	14, // 14: order.OrderService.Logout:output_type -> order.LogoutResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	0,   // 0: order.CreateOrderRequest.amount_to_collect_money:type_name -> order.Money
+	9,   // 1: order.CreateOrderResponse.data:type_name -> order.OrderData
+	0,   // 2: order.OrderData.delivery_fee_money:type_name -> order.Money
+	12,  // 3: order.ListOrdersResponse.data:type_name -> order.OrdersData
+	13,  // 4: order.OrdersData.orders:type_name -> order.Order
+	0,   // 5: order.Order.order_amount_money:type_name -> order.Money
+	0,   // 6: order.Order.total_fee_money:type_name -> order.Money
+	0,   // 7: order.Order.cod_fee_money:type_name -> order.Money
+	0,   // 8: order.Order.promo_discount_money:type_name -> order.Money
+	0,   // 9: order.Order.discount_money:type_name -> order.Money
+	0,   // 10: order.Order.delivery_fee_money:type_name -> order.Money
+	0,   // 11: order.Order.cod_amount_money:type_name -> order.Money
+	0,   // 12: order.Order.delivery_charge_money:type_name -> order.Money
+	0,   // 13: order.Order.amount_to_collect_money:type_name -> order.Money
+	20,  // 14: order.CreateApiKeyResponse.data:type_name -> order.ApiKey
+	20,  // 15: order.ListApiKeysResponse.data:type_name -> order.ApiKey
+	39,  // 16: order.ListSessionsResponse.data:type_name -> order.Session
+	9,   // 17: order.UpdateOrderStatusResponse.data:type_name -> order.OrderData
+	57,  // 18: order.OrderStatusEvent.changes:type_name -> order.OrderFieldChange
+	0,   // 19: order.UpdateOrderRequest.amount_to_collect_money:type_name -> order.Money
+	13,  // 20: order.UpdateOrderResponse.data:type_name -> order.Order
+	13,  // 21: order.GetOrderResponse.data:type_name -> order.Order
+	56,  // 22: order.GetOrderResponse.history:type_name -> order.OrderStatusEvent
+	0,   // 23: order.BulkOrderResult.delivery_fee_money:type_name -> order.Money
+	66,  // 24: order.BulkCreateOrdersResponse.results:type_name -> order.BulkOrderResult
+	0,   // 25: order.WeightBand.surcharge_money:type_name -> order.Money
+	69,  // 26: order.Rate.weight_bands:type_name -> order.WeightBand
+	0,   // 27: order.Rate.base_fee_money:type_name -> order.Money
+	0,   // 28: order.Rate.extra_kg_fee_money:type_name -> order.Money
+	0,   // 29: order.Rate.overweight_fee_money:type_name -> order.Money
+	70,  // 30: order.RateCard.rates:type_name -> order.Rate
+	0,   // 31: order.RateCard.min_cod_fee_money:type_name -> order.Money
+	0,   // 32: order.RateCard.min_delivery_fee_money:type_name -> order.Money
+	70,  // 33: order.CreateRateCardRequest.rates:type_name -> order.Rate
+	0,   // 34: order.CreateRateCardRequest.min_cod_fee_money:type_name -> order.Money
+	0,   // 35: order.CreateRateCardRequest.min_delivery_fee_money:type_name -> order.Money
+	71,  // 36: order.CreateRateCardResponse.data:type_name -> order.RateCard
+	71,  // 37: order.ListRateCardsResponse.data:type_name -> order.RateCard
+	0,   // 38: order.QuoteDeliveryFeeRequest.amount_to_collect_money:type_name -> order.Money
+	0,   // 39: order.FeeQuote.base_fee_money:type_name -> order.Money
+	0,   // 40: order.FeeQuote.weight_surcharge_money:type_name -> order.Money
+	0,   // 41: order.FeeQuote.delivery_fee_money:type_name -> order.Money
+	0,   // 42: order.FeeQuote.cod_fee_money:type_name -> order.Money
+	0,   // 43: order.FeeQuote.discount_money:type_name -> order.Money
+	0,   // 44: order.FeeQuote.total_fee_money:type_name -> order.Money
+	0,   // 45: order.FeeQuote.promo_discount_money:type_name -> order.Money
+	77,  // 46: order.QuoteDeliveryFeeResponse.data:type_name -> order.FeeQuote
+	0,   // 47: order.Promotion.amount_money:type_name -> order.Money
+	0,   // 48: order.Promotion.max_discount_money:type_name -> order.Money
+	0,   // 49: order.CreatePromotionRequest.amount_money:type_name -> order.Money
+	0,   // 50: order.CreatePromotionRequest.max_discount_money:type_name -> order.Money
+	79,  // 51: order.CreatePromotionResponse.data:type_name -> order.Promotion
+	79,  // 52: order.ListPromotionsResponse.data:type_name -> order.Promotion
+	86,  // 53: order.CreateStoreResponse.data:type_name -> order.Store
+	86,  // 54: order.UpdateStoreResponse.data:type_name -> order.Store
+	86,  // 55: order.ListStoresResponse.data:type_name -> order.Store
+	93,  // 56: order.ListCitiesResponse.data:type_name -> order.City
+	94,  // 57: order.ListZonesResponse.data:type_name -> order.Zone
+	95,  // 58: order.ListAreasResponse.data:type_name -> order.Area
+	1,   // 59: order.OrderService.Signup:input_type -> order.SignupRequest
+	3,   // 60: order.OrderService.Login:input_type -> order.LoginRequest
+	7,   // 61: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	10,  // 62: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	14,  // 63: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	16,  // 64: order.OrderService.Logout:input_type -> order.LogoutRequest
+	5,   // 65: order.OrderService.RefreshToken:input_type -> order.RefreshTokenRequest
+	18,  // 66: order.OrderService.UpdateUserRoles:input_type -> order.UpdateUserRolesRequest
+	21,  // 67: order.OrderService.CreateApiKey:input_type -> order.CreateApiKeyRequest
+	23,  // 68: order.OrderService.ListApiKeys:input_type -> order.ListApiKeysRequest
+	25,  // 69: order.OrderService.RevokeApiKey:input_type -> order.RevokeApiKeyRequest
+	27,  // 70: order.OrderService.UnlockAccount:input_type -> order.UnlockAccountRequest
+	29,  // 71: order.OrderService.ChangePassword:input_type -> order.ChangePasswordRequest
+	31,  // 72: order.OrderService.RequestPasswordReset:input_type -> order.RequestPasswordResetRequest
+	33,  // 73: order.OrderService.ResetPassword:input_type -> order.ResetPasswordRequest
+	35,  // 74: order.OrderService.VerifyEmail:input_type -> order.VerifyEmailRequest
+	37,  // 75: order.OrderService.SendVerificationCode:input_type -> order.SendVerificationCodeRequest
+	40,  // 76: order.OrderService.ListSessions:input_type -> order.ListSessionsRequest
+	42,  // 77: order.OrderService.RevokeSession:input_type -> order.RevokeSessionRequest
+	44,  // 78: order.OrderService.EnrollTwoFactor:input_type -> order.EnrollTwoFactorRequest
+	46,  // 79: order.OrderService.ConfirmTwoFactor:input_type -> order.ConfirmTwoFactorRequest
+	48,  // 80: order.OrderService.VerifyTwoFactor:input_type -> order.VerifyTwoFactorRequest
+	50,  // 81: order.OrderService.DisableTwoFactor:input_type -> order.DisableTwoFactorRequest
+	52,  // 82: order.OrderService.RegenerateRecoveryCodes:input_type -> order.RegenerateRecoveryCodesRequest
+	54,  // 83: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	58,  // 84: order.OrderService.UpdateOrder:input_type -> order.UpdateOrderRequest
+	60,  // 85: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
+	62,  // 86: order.OrderService.ArchiveOrders:input_type -> order.ArchiveOrdersRequest
+	64,  // 87: order.OrderService.UnarchiveOrders:input_type -> order.UnarchiveOrdersRequest
+	7,   // 88: order.OrderService.BulkCreateOrders:input_type -> order.CreateOrderRequest
+	68,  // 89: order.OrderService.ImportOrdersCsv:input_type -> order.ImportOrdersCsvRequest
+	72,  // 90: order.OrderService.CreateRateCard:input_type -> order.CreateRateCardRequest
+	74,  // 91: order.OrderService.ListRateCards:input_type -> order.ListRateCardsRequest
+	76,  // 92: order.OrderService.QuoteDeliveryFee:input_type -> order.QuoteDeliveryFeeRequest
+	80,  // 93: order.OrderService.CreatePromotion:input_type -> order.CreatePromotionRequest
+	82,  // 94: order.OrderService.ListPromotions:input_type -> order.ListPromotionsRequest
+	84,  // 95: order.OrderService.SetMerchantDiscount:input_type -> order.SetMerchantDiscountRequest
+	87,  // 96: order.OrderService.CreateStore:input_type -> order.CreateStoreRequest
+	89,  // 97: order.OrderService.UpdateStore:input_type -> order.UpdateStoreRequest
+	91,  // 98: order.OrderService.ListStores:input_type -> order.ListStoresRequest
+	96,  // 99: order.OrderService.ListCities:input_type -> order.ListCitiesRequest
+	98,  // 100: order.OrderService.ListZones:input_type -> order.ListZonesRequest
+	100, // 101: order.OrderService.ListAreas:input_type -> order.ListAreasRequest
+	2,   // 102: order.OrderService.Signup:output_type -> order.SignupResponse
+	4,   // 103: order.OrderService.Login:output_type -> order.LoginResponse
+	8,   // 104: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	11,  // 105: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	15,  // 106: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	17,  // 107: order.OrderService.Logout:output_type -> order.LogoutResponse
+	6,   // 108: order.OrderService.RefreshToken:output_type -> order.RefreshTokenResponse
+	19,  // 109: order.OrderService.UpdateUserRoles:output_type -> order.UpdateUserRolesResponse
+	22,  // 110: order.OrderService.CreateApiKey:output_type -> order.CreateApiKeyResponse
+	24,  // 111: order.OrderService.ListApiKeys:output_type -> order.ListApiKeysResponse
+	26,  // 112: order.OrderService.RevokeApiKey:output_type -> order.RevokeApiKeyResponse
+	28,  // 113: order.OrderService.UnlockAccount:output_type -> order.UnlockAccountResponse
+	30,  // 114: order.OrderService.ChangePassword:output_type -> order.ChangePasswordResponse
+	32,  // 115: order.OrderService.RequestPasswordReset:output_type -> order.RequestPasswordResetResponse
+	34,  // 116: order.OrderService.ResetPassword:output_type -> order.ResetPasswordResponse
+	36,  // 117: order.OrderService.VerifyEmail:output_type -> order.VerifyEmailResponse
+	38,  // 118: order.OrderService.SendVerificationCode:output_type -> order.SendVerificationCodeResponse
+	41,  // 119: order.OrderService.ListSessions:output_type -> order.ListSessionsResponse
+	43,  // 120: order.OrderService.RevokeSession:output_type -> order.RevokeSessionResponse
+	45,  // 121: order.OrderService.EnrollTwoFactor:output_type -> order.EnrollTwoFactorResponse
+	47,  // 122: order.OrderService.ConfirmTwoFactor:output_type -> order.ConfirmTwoFactorResponse
+	49,  // 123: order.OrderService.VerifyTwoFactor:output_type -> order.VerifyTwoFactorResponse
+	51,  // 124: order.OrderService.DisableTwoFactor:output_type -> order.DisableTwoFactorResponse
+	53,  // 125: order.OrderService.RegenerateRecoveryCodes:output_type -> order.RegenerateRecoveryCodesResponse
+	55,  // 126: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	59,  // 127: order.OrderService.UpdateOrder:output_type -> order.UpdateOrderResponse
+	61,  // 128: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
+	63,  // 129: order.OrderService.ArchiveOrders:output_type -> order.ArchiveOrdersResponse
+	65,  // 130: order.OrderService.UnarchiveOrders:output_type -> order.UnarchiveOrdersResponse
+	67,  // 131: order.OrderService.BulkCreateOrders:output_type -> order.BulkCreateOrdersResponse
+	67,  // 132: order.OrderService.ImportOrdersCsv:output_type -> order.BulkCreateOrdersResponse
+	73,  // 133: order.OrderService.CreateRateCard:output_type -> order.CreateRateCardResponse
+	75,  // 134: order.OrderService.ListRateCards:output_type -> order.ListRateCardsResponse
+	78,  // 135: order.OrderService.QuoteDeliveryFee:output_type -> order.QuoteDeliveryFeeResponse
+	81,  // 136: order.OrderService.CreatePromotion:output_type -> order.CreatePromotionResponse
+	83,  // 137: order.OrderService.ListPromotions:output_type -> order.ListPromotionsResponse
+	85,  // 138: order.OrderService.SetMerchantDiscount:output_type -> order.SetMerchantDiscountResponse
+	88,  // 139: order.OrderService.CreateStore:output_type -> order.CreateStoreResponse
+	90,  // 140: order.OrderService.UpdateStore:output_type -> order.UpdateStoreResponse
+	92,  // 141: order.OrderService.ListStores:output_type -> order.ListStoresResponse
+	97,  // 142: order.OrderService.ListCities:output_type -> order.ListCitiesResponse
+	99,  // 143: order.OrderService.ListZones:output_type -> order.ListZonesResponse
+	101, // 144: order.OrderService.ListAreas:output_type -> order.ListAreasResponse
+	102, // [102:145] is the sub-list for method output_type
+	59,  // [59:102] is the sub-list for method input_type
+	59,  // [59:59] is the sub-list for extension type_name
+	59,  // [59:59] is the sub-list for extension extendee
+	0,   // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_internal_adapters_grpc_proto_order_proto_init() }
@@ -1458,7 +8279,7 @@ func file_internal_adapters_grpc_proto_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_adapters_grpc_proto_order_proto_rawDesc), len(file_internal_adapters_grpc_proto_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   102,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
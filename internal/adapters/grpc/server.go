@@ -2,35 +2,48 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"math"
+	"net"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/grpc/proto"
-	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/redis"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/application"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
-	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
-	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
 )
 
 type Server struct {
 	pb.UnimplementedOrderServiceServer
-	authService  *application.AuthService
-	orderService *application.OrderService
+	authService     *application.AuthService
+	orderService    *application.OrderService
+	apiKeyService   *application.APIKeyService
+	pricingService  *application.PricingService
+	storeService    *application.StoreService
+	locationService *application.LocationService
 }
 
-func NewServer(repo ports.OrderRepositoryPort, cache *redis.Cache) *Server {
+func NewServer(authService *application.AuthService, orderService *application.OrderService, apiKeyService *application.APIKeyService, pricingService *application.PricingService, storeService *application.StoreService, locationService *application.LocationService) *Server {
 	return &Server{
-		authService:  application.NewAuthService(repo),
-		orderService: application.NewOrderService(repo, cache),
+		authService:     authService,
+		orderService:    orderService,
+		apiKeyService:   apiKeyService,
+		pricingService:  pricingService,
+		storeService:    storeService,
+		locationService: locationService,
 	}
 }
 
@@ -47,32 +60,180 @@ func (s *Server) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.SignupR
 }
 
 func (s *Server) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	token, user, err := s.authService.Login(ctx, req.Username, req.Password)
+	tokens, _, err := s.authService.Login(ctx, req.Username, req.Password, clientInfoFromContext(ctx))
 	if err != nil {
+		if st := lockoutStatus(ctx, err); st != nil {
+			return nil, st
+		}
 		return &pb.LoginResponse{Message: "Invalid credentials", Type: "error", Code: 400}, nil
 	}
-	token, err = auth.GenerateToken(req.Username, user.ID)
-	if err != nil {
-		return &pb.LoginResponse{Message: err.Error(), Type: "error", Code: 500}, nil
+	if tokens.ChallengeToken != "" {
+		return &pb.LoginResponse{
+			ChallengeToken: tokens.ChallengeToken,
+			Message:        "Two-factor code required",
+			Type:           "success",
+			Code:           200,
+		}, nil
 	}
 	return &pb.LoginResponse{
 		TokenType:    "Bearer",
-		ExpiresIn:    432000,
-		AccessToken:  token,
-		RefreshToken: "dummy-refresh",
+		ExpiresIn:    tokens.ExpiresIn,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		Message:      "Logged in",
+		Type:         "success",
+		Code:         200,
+	}, nil
+}
+
+func (s *Server) VerifyTwoFactor(ctx context.Context, req *pb.VerifyTwoFactorRequest) (*pb.VerifyTwoFactorResponse, error) {
+	tokens, err := s.authService.VerifyTwoFactor(ctx, req.ChallengeToken, req.Code, clientInfoFromContext(ctx))
+	if err != nil {
+		if st := lockoutStatus(ctx, err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, domain.ErrInvalidChallenge) || errors.Is(err, domain.ErrInvalidTwoFactor) {
+			return &pb.VerifyTwoFactorResponse{Message: err.Error(), Type: "error", Code: 401}, nil
+		}
+		return &pb.VerifyTwoFactorResponse{Message: err.Error(), Type: "error", Code: 500}, nil
+	}
+	return &pb.VerifyTwoFactorResponse{
+		TokenType:    "Bearer",
+		ExpiresIn:    tokens.ExpiresIn,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		Message:      "Logged in",
 		Type:         "success",
 		Code:         200,
 	}, nil
 }
 
+// lockoutStatus turns a *domain.LockoutError into a ResourceExhausted status
+// with a retry-after header. It returns nil for other errors.
+func lockoutStatus(ctx context.Context, err error) error {
+	var lockout *domain.LockoutError
+	if !errors.As(err, &lockout) {
+		return nil
+	}
+	grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(int(math.Ceil(lockout.RetryAfter.Seconds())))))
+	return status.Error(codes.ResourceExhausted, lockout.Error())
+}
+
+func (s *Server) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	tokens, err := s.authService.RefreshToken(ctx, req.RefreshToken, clientInfoFromContext(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) || errors.Is(err, domain.ErrRefreshTokenReused) {
+			return &pb.RefreshTokenResponse{Message: err.Error(), Type: "error", Code: 401}, nil
+		}
+		return &pb.RefreshTokenResponse{Message: err.Error(), Type: "error", Code: 500}, nil
+	}
+	return &pb.RefreshTokenResponse{
+		TokenType:    "Bearer",
+		ExpiresIn:    tokens.ExpiresIn,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		Message:      "Token refreshed",
+		Type:         "success",
+		Code:         200,
+	}, nil
+}
+
 func (s *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
 	}
 
+	order, err := orderFromRequest(req)
+	if err != nil {
+		return &pb.CreateOrderResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	if err := applyStoreScope(ctx, order); err != nil {
+		return &pb.CreateOrderResponse{Message: err.Error(), Type: "error", Code: 403}, nil
+	}
+
+	created, err := s.orderService.CreateOrderIdempotent(ctx, order, userID, idempotencyKeyFromContext(ctx))
+	if err != nil {
+		code := int32(422)
+		if errors.Is(err, domain.ErrDuplicateMerchantOrder) || errors.Is(err, domain.ErrIdempotencyKeyExists) || errors.Is(err, domain.ErrPromoCodeExhausted) {
+			code = 409
+		}
+		return &pb.CreateOrderResponse{Message: err.Error(), Type: "error", Code: code}, nil
+	}
+	return &pb.CreateOrderResponse{
+		Message: "Order Created Successfully",
+		Type:    "success",
+		Code:    200,
+		Data: &pb.OrderData{
+			ConsignmentId:    created.ConsignmentID,
+			MerchantOrderId:  created.MerchantOrderID,
+			OrderStatus:      created.Status,
+			DeliveryFee:      created.DeliveryFee.Taka(),
+			DeliveryFeeMoney: toPbMoney(created.DeliveryFee),
+		},
+	}, nil
+}
+
+func (s *Server) QuoteDeliveryFee(ctx context.Context, req *pb.QuoteDeliveryFeeRequest) (*pb.QuoteDeliveryFeeResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	amount, err := moneyFromPb(req.AmountToCollectMoney, req.AmountToCollect)
+	if err != nil {
+		return &pb.QuoteDeliveryFeeResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
 	order := &domain.Order{
+		StoreID:         req.StoreId,
+		RecipientCity:   req.RecipientCity,
+		RecipientZone:   req.RecipientZone,
+		RecipientArea:   req.RecipientArea,
+		DeliveryType:    req.DeliveryType,
+		ItemType:        req.ItemType,
+		ItemQuantity:    req.ItemQuantity,
+		ItemWeight:      req.ItemWeight,
+		AmountToCollect: amount,
+		PromoCode:       req.PromoCode,
+	}
+	if err := applyStoreScope(ctx, order); err != nil {
+		return &pb.QuoteDeliveryFeeResponse{Message: err.Error(), Type: "error", Code: 403}, nil
+	}
+
+	fees, err := s.orderService.QuoteDeliveryFee(ctx, order, userID)
+	if err != nil {
+		return &pb.QuoteDeliveryFeeResponse{Message: err.Error(), Type: "error", Code: 422}, nil
+	}
+	return &pb.QuoteDeliveryFeeResponse{
+		Message: "Delivery fee quoted",
+		Type:    "success",
+		Code:    200,
+		Data: &pb.FeeQuote{
+			BaseFee:              fees.BaseFee.Taka(),
+			WeightSurcharge:      fees.WeightSurcharge.Taka(),
+			DeliveryFee:          fees.DeliveryFee.Taka(),
+			CodFee:               fees.CODFee.Taka(),
+			Discount:             fees.Discount.Taka(),
+			TotalFee:             fees.TotalFee.Taka(),
+			RateCardVersion:      fees.RateCardVersion,
+			PromoDiscount:        fees.PromoDiscount.Taka(),
+			BaseFeeMoney:         toPbMoney(fees.BaseFee),
+			WeightSurchargeMoney: toPbMoney(fees.WeightSurcharge),
+			DeliveryFeeMoney:     toPbMoney(fees.DeliveryFee),
+			CodFeeMoney:          toPbMoney(fees.CODFee),
+			DiscountMoney:        toPbMoney(fees.Discount),
+			TotalFeeMoney:        toPbMoney(fees.TotalFee),
+			PromoDiscountMoney:   toPbMoney(fees.PromoDiscount),
+		},
+	}, nil
+}
+
+func orderFromRequest(req *pb.CreateOrderRequest) (*domain.Order, error) {
+	amount, err := moneyFromPb(req.AmountToCollectMoney, req.AmountToCollect)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Order{
 		StoreID:          req.StoreId,
 		MerchantOrderID:  req.MerchantOrderId,
 		RecipientName:    req.RecipientName,
@@ -86,25 +247,129 @@ func (s *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*
 		Instruction:      req.SpecialInstruction,
 		ItemQuantity:     req.ItemQuantity,
 		ItemWeight:       req.ItemWeight,
-		AmountToCollect:  req.AmountToCollect,
+		AmountToCollect:  amount,
 		Description:      req.ItemDescription,
+		PromoCode:        req.PromoCode,
+	}, nil
+}
+
+// moneyFromPb returns the amount of a request field: the Money value if set,
+// or else the double field of older clients, rounded to the poisha.
+func moneyFromPb(m *pb.Money, legacy float64) (domain.Money, error) {
+	if m == nil {
+		return domain.MoneyFromTaka(legacy), nil
+	}
+	if m.CurrencyCode != "" && m.CurrencyCode != domain.Currency {
+		return 0, fmt.Errorf("unsupported currency %q", m.CurrencyCode)
 	}
+	return domain.Money(m.MinorUnits), nil
+}
+
+func toPbMoney(m domain.Money) *pb.Money {
+	return &pb.Money{MinorUnits: int64(m), CurrencyCode: domain.Currency}
+}
 
-	created, err := s.orderService.CreateOrder(ctx, order, userID)
+var errStoreNotAllowed = errors.New("api key is not allowed for this store")
+
+// applyStoreScope books orders made with a store-scoped API key for that
+// store, and rejects them if they name another store.
+func applyStoreScope(ctx context.Context, order *domain.Order) error {
+	if storeID, ok := ctx.Value("apiKeyStoreID").(int64); ok && storeID != 0 {
+		if order.StoreID == 0 {
+			order.StoreID = storeID
+		} else if order.StoreID != storeID {
+			return errStoreNotAllowed
+		}
+	}
+	return nil
+}
+
+func (s *Server) BulkCreateOrders(stream pb.OrderService_BulkCreateOrdersServer) error {
+	ctx := stream.Context()
+	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
-		return &pb.CreateOrderResponse{Message: err.Error(), Type: "error", Code: 422}, nil
+		return status.Error(codes.Unauthenticated, "Unauthorized")
 	}
-	return &pb.CreateOrderResponse{
-		Message: "Order Created Successfully",
-		Type:    "success",
-		Code:    200,
-		Data: &pb.OrderData{
-			ConsignmentId:   created.ConsignmentID,
-			MerchantOrderId: created.MerchantOrderID,
-			OrderStatus:     created.Status,
-			DeliveryFee:     created.DeliveryFee,
-		},
-	}, nil
+
+	var orders []application.BulkOrder
+	var failed []application.BulkOrderResult
+	for row := 1; ; row++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if row > application.MaxBulkOrders {
+			return stream.SendAndClose(&pb.BulkCreateOrdersResponse{
+				Message: fmt.Sprintf("at most %d orders per request", application.MaxBulkOrders),
+				Type:    "error",
+				Code:    400,
+			})
+		}
+		order, err := orderFromRequest(req)
+		if err == nil {
+			err = applyStoreScope(ctx, order)
+		}
+		if err != nil {
+			failed = append(failed, application.BulkOrderResult{Row: row, Err: err})
+			continue
+		}
+		orders = append(orders, application.BulkOrder{Row: row, Order: order})
+	}
+	return stream.SendAndClose(s.createOrders(ctx, userID, orders, failed))
+}
+
+func (s *Server) ImportOrdersCsv(ctx context.Context, req *pb.ImportOrdersCsvRequest) (*pb.BulkCreateOrdersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	parsed, failed, err := application.ParseOrdersCSV(bytes.NewReader(req.Csv))
+	if err != nil {
+		return &pb.BulkCreateOrdersResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	var orders []application.BulkOrder
+	for _, o := range parsed {
+		if err := applyStoreScope(ctx, o.Order); err != nil {
+			failed = append(failed, application.BulkOrderResult{Row: o.Row, Err: err})
+			continue
+		}
+		orders = append(orders, o)
+	}
+	return s.createOrders(ctx, userID, orders, failed), nil
+}
+
+// createOrders creates the orders of a bulk request and reports every row,
+// including the rows in failed that were rejected before.
+func (s *Server) createOrders(ctx context.Context, userID int64, orders []application.BulkOrder, failed []application.BulkOrderResult) *pb.BulkCreateOrdersResponse {
+	results, err := s.orderService.CreateOrders(ctx, orders, userID)
+	if err != nil {
+		return &pb.BulkCreateOrdersResponse{Message: err.Error(), Type: "error", Code: 400}
+	}
+	results = append(results, failed...)
+	sort.Slice(results, func(i, j int) bool { return results[i].Row < results[j].Row })
+
+	resp := &pb.BulkCreateOrdersResponse{Type: "success", Code: 200}
+	for _, r := range results {
+		result := &pb.BulkOrderResult{Row: int64(r.Row)}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+			resp.Failed++
+		} else {
+			result.ConsignmentId = r.Order.ConsignmentID
+			result.MerchantOrderId = r.Order.MerchantOrderID
+			result.OrderStatus = r.Order.Status
+			result.DeliveryFee = r.Order.DeliveryFee.Taka()
+			result.DeliveryFeeMoney = toPbMoney(r.Order.DeliveryFee)
+			resp.Created++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	resp.Message = fmt.Sprintf("%d orders created, %d failed", resp.Created, resp.Failed)
+	return resp
 }
 
 func (s *Server) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
@@ -113,48 +378,39 @@ func (s *Server) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb
 		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
 	}
 
-	orders, total, err := s.orderService.ListOrders(ctx, userID, req.Limit, req.Page)
+	filter, err := orderFilterFromRequest(req)
+	if err != nil {
+		return &pb.ListOrdersResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	// Keys scoped to a store only see the orders of that store
+	if storeID, ok := ctx.Value("apiKeyStoreID").(int64); ok && storeID != 0 {
+		if filter.StoreID != 0 && filter.StoreID != storeID {
+			return &pb.ListOrdersResponse{Message: "api key is not allowed for this store", Type: "error", Code: 403}, nil
+		}
+		filter.StoreID = storeID
+	}
+
+	// Like the service, limit defaults to 10 and page numbers start at 1
+	limit := req.Limit
+	if limit < 1 {
+		limit = 10
+	}
+	if req.Cursor || req.PageToken != "" {
+		return s.listOrdersByCursor(ctx, userID, filter, req.PageToken, limit, req.IncludeTotal)
+	}
+
+	page := max(req.Page, 1)
+	orders, total, err := s.orderService.ListOrders(ctx, userID, filter, limit, page)
 	if err != nil {
 		return &pb.ListOrdersResponse{Message: err.Error(), Type: "error", Code: 400}, nil
 	}
 
 	var pbOrders []*pb.Order
 	for _, o := range orders {
-		pbOrders = append(pbOrders, &pb.Order{
-			OrderConsignmentId: o.ConsignmentID,
-			OrderCreatedAt:     o.CreatedAt.Format(time.RFC3339),
-			OrderDescription:   o.Description,
-			MerchantOrderId:    o.MerchantOrderID,
-			RecipientName:      o.RecipientName,
-			RecipientAddress:   o.RecipientAddress,
-			RecipientPhone:     o.RecipientPhone,
-			OrderAmount:        o.OrderAmount,
-			TotalFee:           o.TotalFee,
-			Instruction:        o.Instruction,
-			OrderTypeId:        o.OrderTypeID,
-			CodFee:             o.CODFee,
-			PromoDiscount:      o.PromoDiscount,
-			Discount:           o.Discount,
-			DeliveryFee:        o.DeliveryFee,
-			OrderStatus:        o.Status,
-			OrderType:          o.OrderType,
-			ItemType:           o.ItemType,
-			StoreName:          o.StoreName,
-			StoreContactPhone:  o.StoreContactPhone,
-			CodAmount:          o.CODAmount,
-			DeliveryCharge:     o.DeliveryCharge,
-			StoreId:            o.StoreID,
-			RecipientCity:      o.RecipientCity,
-			RecipientZone:      o.RecipientZone,
-			RecipientArea:      o.RecipientArea,
-			DeliveryType:       o.DeliveryType,
-			ItemQuantity:       o.ItemQuantity,
-			ItemWeight:         o.ItemWeight,
-			AmountToCollect:    o.AmountToCollect,
-		})
+		pbOrders = append(pbOrders, toPbOrder(o))
 	}
 
-	lastPage := int64(math.Ceil(float64(total) / float64(req.Limit)))
+	lastPage := int64(math.Ceil(float64(total) / float64(limit)))
 	return &pb.ListOrdersResponse{
 		Message: "Orders successfully fetched.",
 		Type:    "success",
@@ -162,14 +418,155 @@ func (s *Server) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb
 		Data: &pb.OrdersData{
 			Orders:      pbOrders,
 			Total:       total,
-			CurrentPage: req.Page,
-			PerPage:     req.Limit,
+			CurrentPage: page,
+			PerPage:     limit,
 			TotalInPage: int64(len(orders)),
 			LastPage:    lastPage,
 		},
 	}, nil
 }
 
+func (s *Server) listOrdersByCursor(ctx context.Context, userID int64, filter domain.OrderFilter, pageToken string, limit int64, withTotal bool) (*pb.ListOrdersResponse, error) {
+	orders, next, total, err := s.orderService.ListOrdersByCursor(ctx, userID, filter, pageToken, limit, withTotal)
+	if err != nil {
+		return &pb.ListOrdersResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+
+	var pbOrders []*pb.Order
+	for _, o := range orders {
+		pbOrders = append(pbOrders, toPbOrder(o))
+	}
+	return &pb.ListOrdersResponse{
+		Message: "Orders successfully fetched.",
+		Type:    "success",
+		Code:    200,
+		Data: &pb.OrdersData{
+			Orders:        pbOrders,
+			Total:         total,
+			PerPage:       limit,
+			TotalInPage:   int64(len(orders)),
+			NextPageToken: next,
+		},
+	}, nil
+}
+
+func orderFilterFromRequest(req *pb.ListOrdersRequest) (domain.OrderFilter, error) {
+	filter := domain.OrderFilter{
+		Statuses:        req.Status,
+		Stage:           req.TransferStatus,
+		Archived:        req.Archive == 1,
+		RecipientCity:   req.RecipientCity,
+		RecipientZone:   req.RecipientZone,
+		StoreID:         req.StoreId,
+		MerchantOrderID: req.MerchantOrderId,
+		Search:          req.Search,
+		Sort:            req.Sort,
+	}
+	var err error
+	if filter.CreatedFrom, _, err = parseFilterTime(req.CreatedFrom); err != nil {
+		return filter, fmt.Errorf("invalid created_from: %w", err)
+	}
+	var dateOnly bool
+	if filter.CreatedTo, dateOnly, err = parseFilterTime(req.CreatedTo); err != nil {
+		return filter, fmt.Errorf("invalid created_to: %w", err)
+	}
+	if dateOnly {
+		filter.CreatedTo = filter.CreatedTo.AddDate(0, 0, 1)
+	}
+	return filter, nil
+}
+
+// parseFilterTime parses an RFC 3339 timestamp or a YYYY-MM-DD date, and
+// reports whether it was a date. An empty value is the zero time.
+func parseFilterTime(value string) (t time.Time, dateOnly bool, err error) {
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+	t, err = time.Parse(time.RFC3339, value)
+	return t, false, err
+}
+
+func (s *Server) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	order, history, err := s.orderService.GetOrder(ctx, req.ConsignmentId, userID)
+	if err != nil {
+		return &pb.GetOrderResponse{Message: err.Error(), Type: "error", Code: orderErrorCode(err)}, nil
+	}
+	var pbHistory []*pb.OrderStatusEvent
+	for _, e := range history {
+		pbHistory = append(pbHistory, &pb.OrderStatusEvent{
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			ActorId:    e.ActorID,
+			Reason:     e.Reason,
+			CreatedAt:  e.CreatedAt.Format(time.RFC3339),
+			Changes:    toPbOrderChanges(e.Changes),
+		})
+	}
+	return &pb.GetOrderResponse{
+		Message: "Order successfully fetched.",
+		Type:    "success",
+		Code:    200,
+		Data:    toPbOrder(order),
+		History: pbHistory,
+	}, nil
+}
+
+func toPbOrder(o *domain.Order) *pb.Order {
+	return &pb.Order{
+		OrderConsignmentId: o.ConsignmentID,
+		OrderCreatedAt:     o.CreatedAt.Format(time.RFC3339),
+		OrderDescription:   o.Description,
+		MerchantOrderId:    o.MerchantOrderID,
+		RecipientName:      o.RecipientName,
+		RecipientAddress:   o.RecipientAddress,
+		RecipientPhone:     o.RecipientPhone,
+		OrderAmount:        o.OrderAmount.Taka(),
+		TotalFee:           o.TotalFee.Taka(),
+		Instruction:        o.Instruction,
+		OrderTypeId:        o.OrderTypeID,
+		CodFee:             o.CODFee.Taka(),
+		PromoDiscount:      o.PromoDiscount.Taka(),
+		Discount:           o.Discount.Taka(),
+		DeliveryFee:        o.DeliveryFee.Taka(),
+		OrderStatus:        o.Status,
+		OrderType:          o.OrderType,
+		ItemType:           o.ItemType,
+		StoreName:          o.StoreName,
+		StoreContactPhone:  o.StoreContactPhone,
+		CodAmount:          o.CODAmount.Taka(),
+		DeliveryCharge:     o.DeliveryCharge.Taka(),
+		StoreId:            o.StoreID,
+		RecipientCity:      o.RecipientCity,
+		RecipientZone:      o.RecipientZone,
+		RecipientArea:      o.RecipientArea,
+		DeliveryType:       o.DeliveryType,
+		ItemQuantity:       o.ItemQuantity,
+		ItemWeight:         o.ItemWeight,
+		AmountToCollect:    o.AmountToCollect.Taka(),
+		ArchivedAt:         formatOptionalTime(o.ArchivedAt),
+		RateCardVersion:    o.RateCardVersion,
+		PromoCode:          o.PromoCode,
+
+		OrderAmountMoney:     toPbMoney(o.OrderAmount),
+		TotalFeeMoney:        toPbMoney(o.TotalFee),
+		CodFeeMoney:          toPbMoney(o.CODFee),
+		PromoDiscountMoney:   toPbMoney(o.PromoDiscount),
+		DiscountMoney:        toPbMoney(o.Discount),
+		DeliveryFeeMoney:     toPbMoney(o.DeliveryFee),
+		CodAmountMoney:       toPbMoney(o.CODAmount),
+		DeliveryChargeMoney:  toPbMoney(o.DeliveryCharge),
+		AmountToCollectMoney: toPbMoney(o.AmountToCollect),
+	}
+}
+
 func (s *Server) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
@@ -178,11 +575,131 @@ func (s *Server) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*
 
 	err = s.orderService.CancelOrder(ctx, req.ConsignmentId, userID)
 	if err != nil {
-		return &pb.CancelOrderResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+		return &pb.CancelOrderResponse{Message: err.Error(), Type: "error", Code: orderErrorCode(err)}, nil
 	}
 	return &pb.CancelOrderResponse{Message: "Order Cancelled Successfully", Type: "success", Code: 200}, nil
 }
 
+func (s *Server) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest) (*pb.UpdateOrderResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	amount, err := moneyFromPb(req.AmountToCollectMoney, req.AmountToCollect)
+	if err != nil {
+		return &pb.UpdateOrderResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	edit := &domain.Order{
+		RecipientName:    req.RecipientName,
+		RecipientPhone:   req.RecipientPhone,
+		RecipientAddress: req.RecipientAddress,
+		RecipientCity:    req.RecipientCity,
+		RecipientZone:    req.RecipientZone,
+		RecipientArea:    req.RecipientArea,
+		DeliveryType:     req.DeliveryType,
+		ItemType:         req.ItemType,
+		Instruction:      req.SpecialInstruction,
+		ItemQuantity:     req.ItemQuantity,
+		ItemWeight:       req.ItemWeight,
+		AmountToCollect:  amount,
+		Description:      req.ItemDescription,
+	}
+	updated, err := s.orderService.UpdateOrder(ctx, req.ConsignmentId, edit, userID)
+	if err != nil {
+		// Lookups fail like in the other order RPCs, invalid edits like in
+		// CreateOrder
+		code := int32(422)
+		if errors.Is(err, domain.ErrInvalidConsignmentID) || errors.Is(err, domain.ErrOrderNotFound) || errors.Is(err, domain.ErrOrderNotEditable) {
+			code = orderErrorCode(err)
+		}
+		return &pb.UpdateOrderResponse{Message: err.Error(), Type: "error", Code: code}, nil
+	}
+	return &pb.UpdateOrderResponse{Message: "Order updated", Type: "success", Code: 200, Data: toPbOrder(updated)}, nil
+}
+
+func toPbOrderChanges(changes []domain.OrderChange) []*pb.OrderFieldChange {
+	var pbChanges []*pb.OrderFieldChange
+	for _, c := range changes {
+		pbChanges = append(pbChanges, &pb.OrderFieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+	return pbChanges
+}
+
+func (s *Server) ArchiveOrders(ctx context.Context, req *pb.ArchiveOrdersRequest) (*pb.ArchiveOrdersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	archived, err := s.orderService.ArchiveOrders(ctx, userID, req.ConsignmentIds)
+	if err != nil {
+		return &pb.ArchiveOrdersResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.ArchiveOrdersResponse{
+		Message:        fmt.Sprintf("%d orders archived", len(archived)),
+		Type:           "success",
+		Code:           200,
+		ConsignmentIds: archived,
+	}, nil
+}
+
+func (s *Server) UnarchiveOrders(ctx context.Context, req *pb.UnarchiveOrdersRequest) (*pb.UnarchiveOrdersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	restored, err := s.orderService.UnarchiveOrders(ctx, userID, req.ConsignmentIds)
+	if err != nil {
+		return &pb.UnarchiveOrdersResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.UnarchiveOrdersResponse{
+		Message:        fmt.Sprintf("%d orders unarchived", len(restored)),
+		Type:           "success",
+		Code:           200,
+		ConsignmentIds: restored,
+	}, nil
+}
+
+func (s *Server) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	order, err := s.orderService.UpdateOrderStatus(ctx, req.ConsignmentId, req.Status, req.Reason, userID)
+	if err != nil {
+		return &pb.UpdateOrderStatusResponse{Message: err.Error(), Type: "error", Code: orderErrorCode(err)}, nil
+	}
+	return &pb.UpdateOrderStatusResponse{
+		Message: "Order status updated",
+		Type:    "success",
+		Code:    200,
+		Data: &pb.OrderData{
+			ConsignmentId:    order.ConsignmentID,
+			MerchantOrderId:  order.MerchantOrderID,
+			OrderStatus:      order.Status,
+			DeliveryFee:      order.DeliveryFee.Taka(),
+			DeliveryFeeMoney: toPbMoney(order.DeliveryFee),
+		},
+	}, nil
+}
+
+// orderErrorCode maps errors of order lookups and status changes to response
+// codes.
+func orderErrorCode(err error) int32 {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotFound):
+		return 404
+	case errors.Is(err, domain.ErrOrderStatusConflict), errors.Is(err, domain.ErrOrderNotEditable):
+		return 409
+	case errors.Is(err, domain.ErrInvalidStatusTransition):
+		return 422
+	}
+	return 400
+}
+
 func (s *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
@@ -196,40 +713,736 @@ func (s *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutR
 	return &pb.LogoutResponse{Message: "Successfully logged out", Type: "success", Code: 200}, nil
 }
 
-func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if info.FullMethod == "/order.OrderService/Login" || info.FullMethod == "/order.OrderService/Signup" {
-		return handler(ctx, req)
+func (s *Server) UpdateUserRoles(ctx context.Context, req *pb.UpdateUserRolesRequest) (*pb.UpdateUserRolesResponse, error) {
+	err := s.authService.UpdateUserRoles(ctx, req.UserId, req.Roles)
+	if err != nil {
+		return &pb.UpdateUserRolesResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.UpdateUserRolesResponse{Message: "Roles updated", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) CreateRateCard(ctx context.Context, req *pb.CreateRateCardRequest) (*pb.CreateRateCardResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	card := &domain.RateCard{CODPercent: req.CodPercent}
+	if card.MinCODFee, err = moneyFromPb(req.MinCodFeeMoney, req.MinCodFee); err != nil {
+		return &pb.CreateRateCardResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	if card.MinDeliveryFee, err = moneyFromPb(req.MinDeliveryFeeMoney, req.MinDeliveryFee); err != nil {
+		return &pb.CreateRateCardResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	if req.EffectiveFrom != "" {
+		card.EffectiveFrom, err = time.Parse(time.RFC3339, req.EffectiveFrom)
+		if err != nil {
+			return &pb.CreateRateCardResponse{Message: "effective_from must be an RFC 3339 time", Type: "error", Code: 400}, nil
+		}
+	}
+	for _, r := range req.Rates {
+		rate, err := rateFromPb(r)
+		if err != nil {
+			return &pb.CreateRateCardResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+		}
+		card.Rates = append(card.Rates, rate)
+	}
+
+	created, err := s.pricingService.CreateRateCard(ctx, card, userID)
+	if err != nil {
+		return &pb.CreateRateCardResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.CreateRateCardResponse{Message: "Rate card created", Type: "success", Code: 200, Data: toPbRateCard(created)}, nil
+}
+
+func (s *Server) ListRateCards(ctx context.Context, req *pb.ListRateCardsRequest) (*pb.ListRateCardsResponse, error) {
+	cards, err := s.pricingService.ListRateCards(ctx)
+	if err != nil {
+		return &pb.ListRateCardsResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	resp := &pb.ListRateCardsResponse{Message: "Rate cards retrieved", Type: "success", Code: 200}
+	for _, c := range cards {
+		resp.Data = append(resp.Data, toPbRateCard(c))
+	}
+	return resp, nil
+}
+
+func rateFromPb(r *pb.Rate) (domain.Rate, error) {
+	rate := domain.Rate{
+		City:         r.City,
+		Zone:         r.Zone,
+		Area:         r.Area,
+		DeliveryType: r.DeliveryType,
+		ItemType:     r.ItemType,
+	}
+	var err error
+	if rate.BaseFee, err = moneyFromPb(r.BaseFeeMoney, r.BaseFee); err != nil {
+		return rate, err
+	}
+	if rate.OverweightFee, err = moneyFromPb(r.OverweightFeeMoney, r.OverweightFee); err != nil {
+		return rate, err
+	}
+	if rate.ExtraKgFee, err = moneyFromPb(r.ExtraKgFeeMoney, r.ExtraKgFee); err != nil {
+		return rate, err
+	}
+	for _, b := range r.WeightBands {
+		surcharge, err := moneyFromPb(b.SurchargeMoney, b.Surcharge)
+		if err != nil {
+			return rate, err
+		}
+		rate.WeightBands = append(rate.WeightBands, domain.WeightBand{MaxWeight: b.MaxWeight, Surcharge: surcharge})
+	}
+	return rate, nil
+}
+
+func toPbRateCard(c *domain.RateCard) *pb.RateCard {
+	card := &pb.RateCard{
+		Version:             c.Version,
+		EffectiveFrom:       c.EffectiveFrom.Format(time.RFC3339),
+		CodPercent:          c.CODPercent,
+		MinCodFee:           c.MinCODFee.Taka(),
+		MinDeliveryFee:      c.MinDeliveryFee.Taka(),
+		CreatedAt:           c.CreatedAt.Format(time.RFC3339),
+		MinCodFeeMoney:      toPbMoney(c.MinCODFee),
+		MinDeliveryFeeMoney: toPbMoney(c.MinDeliveryFee),
+	}
+	for _, r := range c.Rates {
+		rate := &pb.Rate{
+			City:               r.City,
+			Zone:               r.Zone,
+			Area:               r.Area,
+			DeliveryType:       r.DeliveryType,
+			ItemType:           r.ItemType,
+			BaseFee:            r.BaseFee.Taka(),
+			OverweightFee:      r.OverweightFee.Taka(),
+			ExtraKgFee:         r.ExtraKgFee.Taka(),
+			BaseFeeMoney:       toPbMoney(r.BaseFee),
+			OverweightFeeMoney: toPbMoney(r.OverweightFee),
+			ExtraKgFeeMoney:    toPbMoney(r.ExtraKgFee),
+		}
+		for _, b := range r.WeightBands {
+			rate.WeightBands = append(rate.WeightBands, &pb.WeightBand{
+				MaxWeight:      b.MaxWeight,
+				Surcharge:      b.Surcharge.Taka(),
+				SurchargeMoney: toPbMoney(b.Surcharge),
+			})
+		}
+		card.Rates = append(card.Rates, rate)
+	}
+	return card
+}
+
+func (s *Server) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.CreatePromotionResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	promo := &domain.Promotion{
+		Code:           req.Code,
+		Kind:           req.Kind,
+		Value:          req.Value,
+		MaxRedemptions: req.MaxRedemptions,
+		MaxPerMerchant: req.MaxPerMerchant,
+		MerchantID:     req.MerchantId,
+		Cities:         req.Cities,
+		DeliveryTypes:  req.DeliveryTypes,
+	}
+	if promo.MaxDiscount, err = moneyFromPb(req.MaxDiscountMoney, req.MaxDiscount); err != nil {
+		return &pb.CreatePromotionResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	// The value of a flat promotion is an amount of taka
+	if promo.Kind == domain.PromotionFlat {
+		if promo.Amount, err = moneyFromPb(req.AmountMoney, req.Value); err != nil {
+			return &pb.CreatePromotionResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+		}
+		promo.Value = 0
+	}
+	if req.StartsAt != "" {
+		promo.StartsAt, err = time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return &pb.CreatePromotionResponse{Message: "starts_at must be an RFC 3339 time", Type: "error", Code: 400}, nil
+		}
+	}
+	if req.EndsAt != "" {
+		endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil {
+			return &pb.CreatePromotionResponse{Message: "ends_at must be an RFC 3339 time", Type: "error", Code: 400}, nil
+		}
+		promo.EndsAt = &endsAt
+	}
+
+	created, err := s.pricingService.CreatePromotion(ctx, promo, userID)
+	if err != nil {
+		return &pb.CreatePromotionResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.CreatePromotionResponse{Message: "Promotion created", Type: "success", Code: 200, Data: toPbPromotion(created)}, nil
+}
+
+func (s *Server) ListPromotions(ctx context.Context, req *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	promos, err := s.pricingService.ListPromotions(ctx)
+	if err != nil {
+		return &pb.ListPromotionsResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	resp := &pb.ListPromotionsResponse{Message: "Promotions retrieved", Type: "success", Code: 200}
+	for _, p := range promos {
+		resp.Data = append(resp.Data, toPbPromotion(p))
+	}
+	return resp, nil
+}
+
+func toPbPromotion(p *domain.Promotion) *pb.Promotion {
+	promo := &pb.Promotion{
+		Id:               p.ID,
+		Code:             p.Code,
+		Kind:             p.Kind,
+		Value:            p.Value,
+		MaxDiscount:      p.MaxDiscount.Taka(),
+		StartsAt:         p.StartsAt.Format(time.RFC3339),
+		EndsAt:           formatOptionalTime(p.EndsAt),
+		MaxRedemptions:   p.MaxRedemptions,
+		MaxPerMerchant:   p.MaxPerMerchant,
+		MerchantId:       p.MerchantID,
+		Cities:           p.Cities,
+		DeliveryTypes:    p.DeliveryTypes,
+		Redemptions:      p.Redemptions,
+		CreatedAt:        p.CreatedAt.Format(time.RFC3339),
+		MaxDiscountMoney: toPbMoney(p.MaxDiscount),
+	}
+	if p.Kind == domain.PromotionFlat {
+		promo.Value = p.Amount.Taka()
+		promo.AmountMoney = toPbMoney(p.Amount)
+	}
+	return promo
+}
+
+func (s *Server) SetMerchantDiscount(ctx context.Context, req *pb.SetMerchantDiscountRequest) (*pb.SetMerchantDiscountResponse, error) {
+	err := s.pricingService.SetMerchantDiscount(ctx, req.UserId, req.DiscountPercent)
+	if err != nil {
+		return &pb.SetMerchantDiscountResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.SetMerchantDiscountResponse{Message: "Merchant discount updated", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.CreateStoreResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	store := &domain.Store{
+		Name:                req.Name,
+		ContactPhone:        req.ContactPhone,
+		PickupAddress:       req.PickupAddress,
+		DefaultDeliveryType: req.DefaultDeliveryType,
+	}
+	created, err := s.storeService.CreateStore(ctx, store, userID)
+	if err != nil {
+		return &pb.CreateStoreResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.CreateStoreResponse{Message: "Store created", Type: "success", Code: 200, Data: toPbStore(created)}, nil
+}
+
+func (s *Server) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (*pb.UpdateStoreResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	store := &domain.Store{
+		ID:                  req.StoreId,
+		Name:                req.Name,
+		ContactPhone:        req.ContactPhone,
+		PickupAddress:       req.PickupAddress,
+		DefaultDeliveryType: req.DefaultDeliveryType,
+	}
+	updated, err := s.storeService.UpdateStore(ctx, store, userID)
+	if err != nil {
+		code := int32(400)
+		if errors.Is(err, domain.ErrStoreNotFound) {
+			code = 404
+		}
+		return &pb.UpdateStoreResponse{Message: err.Error(), Type: "error", Code: code}, nil
+	}
+	return &pb.UpdateStoreResponse{Message: "Store updated", Type: "success", Code: 200, Data: toPbStore(updated)}, nil
+}
+
+func (s *Server) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	stores, err := s.storeService.ListStores(ctx, userID)
+	if err != nil {
+		return &pb.ListStoresResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	resp := &pb.ListStoresResponse{Message: "Stores retrieved", Type: "success", Code: 200}
+	for _, st := range stores {
+		resp.Data = append(resp.Data, toPbStore(st))
+	}
+	return resp, nil
+}
+
+func toPbStore(st *domain.Store) *pb.Store {
+	return &pb.Store{
+		Id:                  st.ID,
+		Name:                st.Name,
+		ContactPhone:        st.ContactPhone,
+		PickupAddress:       st.PickupAddress,
+		DefaultDeliveryType: st.DefaultDeliveryType,
+		CreatedAt:           st.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           st.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func (s *Server) ListCities(ctx context.Context, req *pb.ListCitiesRequest) (*pb.ListCitiesResponse, error) {
+	cities, err := s.locationService.ListCities(ctx)
+	if err != nil {
+		return &pb.ListCitiesResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	resp := &pb.ListCitiesResponse{Message: "Cities retrieved", Type: "success", Code: 200}
+	for _, c := range cities {
+		resp.Data = append(resp.Data, &pb.City{Id: c.ID, Name: c.Name})
+	}
+	return resp, nil
+}
+
+func (s *Server) ListZones(ctx context.Context, req *pb.ListZonesRequest) (*pb.ListZonesResponse, error) {
+	zones, err := s.locationService.ListZones(ctx, req.CityId)
+	if err != nil {
+		return &pb.ListZonesResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	resp := &pb.ListZonesResponse{Message: "Zones retrieved", Type: "success", Code: 200}
+	for _, z := range zones {
+		resp.Data = append(resp.Data, &pb.Zone{Id: z.ID, CityId: z.CityID, Name: z.Name})
+	}
+	return resp, nil
+}
+
+func (s *Server) ListAreas(ctx context.Context, req *pb.ListAreasRequest) (*pb.ListAreasResponse, error) {
+	areas, err := s.locationService.ListAreas(ctx, req.ZoneId)
+	if err != nil {
+		return &pb.ListAreasResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	resp := &pb.ListAreasResponse{Message: "Areas retrieved", Type: "success", Code: 200}
+	for _, a := range areas {
+		resp.Data = append(resp.Data, &pb.Area{Id: a.ID, ZoneId: a.ZoneID, Name: a.Name, Serviceable: a.Serviceable})
+	}
+	return resp, nil
+}
+
+func (s *Server) UnlockAccount(ctx context.Context, req *pb.UnlockAccountRequest) (*pb.UnlockAccountResponse, error) {
+	err := s.authService.UnlockAccount(ctx, req.Username)
+	if err != nil {
+		return &pb.UnlockAccountResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.UnlockAccountResponse{Message: "Account unlocked", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	err = s.authService.ChangePassword(ctx, userID, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		return &pb.ChangePasswordResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.ChangePasswordResponse{Message: "Password changed, please log in again", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	err := s.authService.RequestPasswordReset(ctx, req.Username)
+	if err != nil {
+		return &pb.RequestPasswordResetResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.RequestPasswordResetResponse{Message: "If the account exists, a reset token has been sent", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	err := s.authService.ResetPassword(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidResetToken) {
+			return &pb.ResetPasswordResponse{Message: err.Error(), Type: "error", Code: 401}, nil
+		}
+		return &pb.ResetPasswordResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.ResetPasswordResponse{Message: "Password reset, please log in again", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	err = s.authService.VerifyEmail(ctx, userID, req.Code)
+	if err != nil {
+		return &pb.VerifyEmailResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.VerifyEmailResponse{Message: "Email address verified", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) SendVerificationCode(ctx context.Context, req *pb.SendVerificationCodeRequest) (*pb.SendVerificationCodeResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	err = s.authService.SendVerificationCode(ctx, userID)
+	if err != nil {
+		return &pb.SendVerificationCodeResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.SendVerificationCodeResponse{Message: "Verification code sent", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	sessions, err := s.authService.ListSessions(ctx, userID)
+	if err != nil {
+		return &pb.ListSessionsResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	current, _ := ctx.Value("sessionID").(string)
+	var pbSessions []*pb.Session
+	for _, sess := range sessions {
+		pbSessions = append(pbSessions, &pb.Session{
+			Id:         sess.ID,
+			Device:     sess.Device,
+			Ip:         sess.IP,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt.Format(time.RFC3339),
+			LastSeenAt: sess.LastSeenAt.Format(time.RFC3339),
+			Current:    sess.ID == current,
+		})
+	}
+	return &pb.ListSessionsResponse{Message: "Sessions successfully fetched.", Type: "success", Code: 200, Data: pbSessions}, nil
+}
+
+func (s *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	err = s.authService.RevokeSession(ctx, userID, req.SessionId)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return &pb.RevokeSessionResponse{Message: err.Error(), Type: "error", Code: 404}, nil
+		}
+		return &pb.RevokeSessionResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.RevokeSessionResponse{Message: "Session revoked", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) EnrollTwoFactor(ctx context.Context, req *pb.EnrollTwoFactorRequest) (*pb.EnrollTwoFactorResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	secret, uri, err := s.authService.EnrollTwoFactor(ctx, userID)
+	if err != nil {
+		return &pb.EnrollTwoFactorResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.EnrollTwoFactorResponse{
+		Message:         "Add the secret to your authenticator app, then confirm with a code",
+		Type:            "success",
+		Code:            200,
+		Secret:          secret,
+		ProvisioningUri: uri,
+	}, nil
+}
+
+func (s *Server) ConfirmTwoFactor(ctx context.Context, req *pb.ConfirmTwoFactorRequest) (*pb.ConfirmTwoFactorResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	recoveryCodes, err := s.authService.ConfirmTwoFactor(ctx, userID, req.Code)
+	if err != nil {
+		return &pb.ConfirmTwoFactorResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.ConfirmTwoFactorResponse{
+		Message:       "Two-factor authentication enabled. Store the recovery codes now, they will not be shown again",
+		Type:          "success",
+		Code:          200,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *Server) DisableTwoFactor(ctx context.Context, req *pb.DisableTwoFactorRequest) (*pb.DisableTwoFactorResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	err = s.authService.DisableTwoFactor(ctx, userID, req.Code)
+	if err != nil {
+		if st := lockoutStatus(ctx, err); st != nil {
+			return nil, st
+		}
+		return &pb.DisableTwoFactorResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.DisableTwoFactorResponse{Message: "Two-factor authentication disabled", Type: "success", Code: 200}, nil
+}
+
+func (s *Server) RegenerateRecoveryCodes(ctx context.Context, req *pb.RegenerateRecoveryCodesRequest) (*pb.RegenerateRecoveryCodesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	recoveryCodes, err := s.authService.RegenerateRecoveryCodes(ctx, userID, req.Code)
+	if err != nil {
+		if st := lockoutStatus(ctx, err); st != nil {
+			return nil, st
+		}
+		return &pb.RegenerateRecoveryCodesResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.RegenerateRecoveryCodesResponse{
+		Message:       "Recovery codes regenerated. Store them now, they will not be shown again",
+		Type:          "success",
+		Code:          200,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *Server) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	for _, m := range req.Methods {
+		if !methodPolicies[fullMethodName(m)].apiKey {
+			return &pb.CreateApiKeyResponse{Message: fmt.Sprintf("method %q cannot be called with an api key", m), Type: "error", Code: 400}, nil
+		}
+	}
+
+	key, apiKey, err := s.apiKeyService.CreateAPIKey(ctx, userID, req.Name, req.StoreId, req.Methods)
+	if err != nil {
+		return &pb.CreateApiKeyResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.CreateApiKeyResponse{
+		Message: "API key created. Store it now, it will not be shown again",
+		Type:    "success",
+		Code:    200,
+		Data:    toPbAPIKey(apiKey),
+		Key:     key,
+	}, nil
+}
+
+func (s *Server) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	keys, err := s.apiKeyService.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return &pb.ListApiKeysResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	var pbKeys []*pb.ApiKey
+	for _, k := range keys {
+		pbKeys = append(pbKeys, toPbAPIKey(k))
+	}
+	return &pb.ListApiKeysResponse{Message: "API keys successfully fetched.", Type: "success", Code: 200, Data: pbKeys}, nil
+}
+
+func (s *Server) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	err = s.apiKeyService.RevokeAPIKey(ctx, req.Id, userID)
+	if err != nil {
+		return &pb.RevokeApiKeyResponse{Message: err.Error(), Type: "error", Code: 400}, nil
+	}
+	return &pb.RevokeApiKeyResponse{Message: "API key revoked", Type: "success", Code: 200}, nil
+}
+
+func toPbAPIKey(k *domain.APIKey) *pb.ApiKey {
+	return &pb.ApiKey{
+		Id:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		StoreId:    k.StoreID,
+		Methods:    k.Methods,
+		CreatedAt:  k.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: formatOptionalTime(k.LastUsedAt),
+		RevokedAt:  formatOptionalTime(k.RevokedAt),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// AuthInterceptor authenticates every RPC except the public ones, enforces
+// methodPolicies and stores the caller's user ID, roles, raw token and session
+// ID in the context for the handlers. Callers authenticate with a Bearer JWT or, for
+// methods that allow it, with an API key in the x-api-key header.
+func (s *Server) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := s.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor applies the method policies of AuthInterceptor to
+// streaming RPCs.
+func (s *Server) StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticatedStream is a server stream whose context carries the caller.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize checks the caller of method against its policy and returns ctx
+// with the caller's identity added.
+func (s *Server) authorize(ctx context.Context, method string) (context.Context, error) {
+	policy, ok := methodPolicies[method]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method not allowed")
+	}
+	if policy.public {
+		return ctx, nil
 	}
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "missing metadata")
 	}
+	if key := apiKeyFromMetadata(md); key != "" {
+		return s.authenticateAPIKey(ctx, key, policy, method)
+	}
 	authHeader := md.Get("authorization")
 	if len(authHeader) == 0 {
 		return nil, status.Error(codes.Unauthenticated, "missing authorization")
 	}
 	token := strings.TrimPrefix(authHeader[0], "Bearer ")
-	claims, err := auth.ValidateToken(token)
+	claims, err := s.authService.Authenticate(ctx, token)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid token")
+		if errors.Is(err, domain.ErrInvalidToken) || errors.Is(err, domain.ErrTokenRevoked) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, "unable to verify token")
+	}
+	if !policy.allows(claims.Roles) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if policy.verified {
+		if err := s.authService.RequireVerifiedEmail(ctx, claims.UserID); err != nil {
+			if errors.Is(err, domain.ErrEmailNotVerified) {
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			}
+			if errors.Is(err, domain.ErrInvalidToken) {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			return nil, status.Error(codes.Unavailable, "unable to verify token")
+		}
 	}
 	ctx = context.WithValue(ctx, "userID", claims.UserID)
-	return handler(ctx, req)
+	ctx = context.WithValue(ctx, "roles", claims.Roles)
+	ctx = context.WithValue(ctx, "token", token)
+	ctx = context.WithValue(ctx, "sessionID", claims.SessionID)
+	return ctx, nil
 }
 
-func getUserIDFromContext(ctx context.Context) (int64, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, errors.New("missing metadata")
-	}
-	authHeader := md.Get("authorization")
-	if len(authHeader) == 0 {
-		return 0, errors.New("missing authorization")
+func (s *Server) authenticateAPIKey(ctx context.Context, key string, policy methodPolicy, method string) (context.Context, error) {
+	if !policy.apiKey {
+		return nil, status.Error(codes.PermissionDenied, "method cannot be called with an api key")
 	}
-	token := strings.TrimPrefix(authHeader[0], "Bearer ")
-	claims, err := auth.ValidateToken(token)
+	apiKey, user, err := s.apiKeyService.Authenticate(ctx, key, path.Base(method))
 	if err != nil {
-		return 0, err
+		if errors.Is(err, domain.ErrInvalidAPIKey) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, domain.ErrAPIKeyNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, "unable to verify api key")
+	}
+	if !policy.allows(user.Roles) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if policy.verified && !user.EmailVerified {
+		return nil, status.Error(codes.PermissionDenied, domain.ErrEmailNotVerified.Error())
+	}
+	ctx = context.WithValue(ctx, "userID", user.ID)
+	ctx = context.WithValue(ctx, "roles", user.Roles)
+	ctx = context.WithValue(ctx, "apiKeyStoreID", apiKey.StoreID)
+	return ctx, nil
+}
+
+func apiKeyFromMetadata(md metadata.MD) string {
+	if key := md.Get("x-api-key"); len(key) > 0 {
+		return key[0]
+	}
+	if authHeader := md.Get("authorization"); len(authHeader) > 0 && strings.HasPrefix(authHeader[0], "ApiKey ") {
+		return strings.TrimPrefix(authHeader[0], "ApiKey ")
+	}
+	return ""
+}
+
+// idempotencyKeyFromContext returns the idempotency-key metadata of the
+// request, or "" if it has none.
+func idempotencyKeyFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if key := md.Get("idempotency-key"); len(key) > 0 {
+			return strings.TrimSpace(key[0])
+		}
+	}
+	return ""
+}
+
+// clientInfoFromContext describes the caller from the transport's peer
+// address and the user-agent and x-device-name metadata.
+func clientInfoFromContext(ctx context.Context) domain.ClientInfo {
+	var info domain.ClientInfo
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		info.IP = host
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ua := md.Get("user-agent"); len(ua) > 0 {
+			info.UserAgent = ua[0]
+		}
+		if device := md.Get("x-device-name"); len(device) > 0 {
+			info.Device = device[0]
+		}
+	}
+	return info
+}
+
+func getUserIDFromContext(ctx context.Context) (int64, error) {
+	userID, ok := ctx.Value("userID").(int64)
+	if !ok {
+		return 0, errors.New("missing authenticated user")
 	}
-	return claims.UserID, nil
+	return userID, nil
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"os"
 	"testing"
 	"time"
 
@@ -19,13 +20,19 @@ import (
 
 	_ "github.com/lib/pq"
 	pb "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/grpc/proto"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/idgen"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/notifier"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/redis"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/repository"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/revocation"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/application"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
 )
 
 const bufSize = 1024 * 1024
 
 var lis *bufconn.Listener
+var testDB *sql.DB
 
 func setupTestServer(t *testing.T) (*grpc.ClientConn, pb.OrderServiceClient) {
 	lis = bufconn.Listen(bufSize)
@@ -37,14 +44,34 @@ func setupTestServer(t *testing.T) (*grpc.ClientConn, pb.OrderServiceClient) {
 	if err := db.Ping(); err != nil {
 		t.Fatalf("failed to ping DB: %v", err)
 	}
+	testDB = db
 	cache := redis.NewCache("localhost:6379", "", "",0, 5*time.Minute)
 	if err := cache.Ping(context.Background()); err != nil {
 		t.Fatalf("failed to connect to Redis: %v", err)
 	}
 	repo := repository.NewPostgresRepository(db)
-	srv := NewServer(repo, cache)
+	authService := application.NewAuthService(repo, revocation.NewCacheStore(cache), cache, notifier.NewLogNotifier(), application.DefaultLockoutConfig())
+	ids, err := idgen.NewSequenceGenerator(db, domain.DefaultConsignmentIDFormat)
+	if err != nil {
+		t.Fatalf("failed to create ID generator: %v", err)
+	}
+	pricingService := application.NewPricingService(repo)
+	if err := pricingService.EnsureRateCard(context.Background()); err != nil {
+		t.Fatalf("failed to create default rate card: %v", err)
+	}
+	locationService := application.NewLocationService(repo)
+	seed, err := os.Open("../../../cmd/server/locations.json")
+	if err != nil {
+		t.Fatalf("failed to open location seed: %v", err)
+	}
+	defer seed.Close()
+	if err := locationService.Seed(context.Background(), seed); err != nil {
+		t.Fatalf("failed to seed locations: %v", err)
+	}
+	orderService := application.NewOrderService(repo, cache, ids, pricingService, locationService)
+	srv := NewServer(authService, orderService, application.NewAPIKeyService(repo), pricingService, application.NewStoreService(repo), locationService)
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(AuthInterceptor))
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(srv.AuthInterceptor))
 	pb.RegisterOrderServiceServer(grpcServer, srv)
 
 	go func() {
@@ -74,7 +101,7 @@ func TestGRPCServer(t *testing.T) {
 	t.Run("Signup_Success", func(t *testing.T) {
 		resp, err := client.Signup(ctx, &pb.SignupRequest{
 			Username: fmt.Sprintf("testuser%d@example.com", time.Now().UnixNano()),
-			Password: "securepass",
+			Password: "securepass1",
 		})
 		if err != nil {
 			t.Errorf("Signup failed: %v", err)
@@ -86,11 +113,11 @@ func TestGRPCServer(t *testing.T) {
 
 	t.Run("Signup_DuplicateUsername", func(t *testing.T) {
 		username := fmt.Sprintf("testuser%d@example.com", time.Now().UnixNano())
-		_, err := client.Signup(ctx, &pb.SignupRequest{Username: username, Password: "securepass"})
+		_, err := client.Signup(ctx, &pb.SignupRequest{Username: username, Password: "securepass1"})
 		if err != nil {
 			t.Errorf("First signup failed: %v", err)
 		}
-		resp, err := client.Signup(ctx, &pb.SignupRequest{Username: username, Password: "securepass"})
+		resp, err := client.Signup(ctx, &pb.SignupRequest{Username: username, Password: "securepass1"})
 		if err != nil {
 			t.Errorf("Signup failed: %v", err)
 		}
@@ -100,14 +127,18 @@ func TestGRPCServer(t *testing.T) {
 	})
 
 	// Test Login
-	var token string
+	var token, refreshToken string
 	t.Run("Login_Success", func(t *testing.T) {
 		username := fmt.Sprintf("testuser%d@example.com", time.Now().UnixNano())
-		_, err := client.Signup(ctx, &pb.SignupRequest{Username: username, Password: "securepass"})
+		_, err := client.Signup(ctx, &pb.SignupRequest{Username: username, Password: "securepass1"})
 		if err != nil {
 			t.Errorf("Signup failed: %v", err)
 		}
-		resp, err := client.Login(ctx, &pb.LoginRequest{Username: username, Password: "securepass"})
+		// Order RPCs need a verified email; the emailed code itself is covered by unit tests
+		if _, err := testDB.Exec("UPDATE users SET email_verified = TRUE WHERE username = $1", username); err != nil {
+			t.Errorf("Failed to verify email: %v", err)
+		}
+		resp, err := client.Login(ctx, &pb.LoginRequest{Username: username, Password: "securepass1"})
 		if err != nil {
 			t.Errorf("Login failed: %v", err)
 		}
@@ -115,6 +146,26 @@ func TestGRPCServer(t *testing.T) {
 			t.Errorf("Login response = %v, want code 200, type success, non-empty token", resp)
 		}
 		token = resp.AccessToken
+		refreshToken = resp.RefreshToken
+	})
+
+	t.Run("RefreshToken_Rotation", func(t *testing.T) {
+		resp, err := client.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: refreshToken})
+		if err != nil {
+			t.Errorf("RefreshToken failed: %v", err)
+		}
+		if resp.Code != 200 || resp.AccessToken == "" || resp.RefreshToken == "" || resp.RefreshToken == refreshToken {
+			t.Errorf("RefreshToken response = %v, want code 200 and a rotated refresh token", resp)
+		}
+
+		// Replaying the old refresh token is reuse and must be rejected
+		resp, err = client.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: refreshToken})
+		if err != nil {
+			t.Errorf("RefreshToken failed: %v", err)
+		}
+		if resp.Code != 401 {
+			t.Errorf("RefreshToken replay response = %v, want code 401", resp)
+		}
 	})
 
 	t.Run("Login_InvalidCredentials", func(t *testing.T) {
@@ -127,11 +178,28 @@ func TestGRPCServer(t *testing.T) {
 		}
 	})
 
+	var storeID int64
+	t.Run("CreateStore_Success", func(t *testing.T) {
+		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		resp, err := client.CreateStore(ctx, &pb.CreateStoreRequest{
+			Name:          "Test Store",
+			ContactPhone:  "01812345678",
+			PickupAddress: "House 1, Road 2, Banani, Dhaka",
+		})
+		if err != nil {
+			t.Fatalf("CreateStore failed: %v", err)
+		}
+		if resp.Code != 200 || resp.Data.Id == 0 {
+			t.Fatalf("CreateStore response = %v, want code 200 and a store id", resp)
+		}
+		storeID = resp.Data.Id
+	})
+
 	// Test CreateOrder
 	t.Run("CreateOrder_Success", func(t *testing.T) {
 		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
 		resp, err := client.CreateOrder(ctx, &pb.CreateOrderRequest{
-			StoreId:          1,
+			StoreId:          storeID,
 			RecipientName:    "John Doe",
 			RecipientPhone:   "01712345678",
 			RecipientAddress: "123 Main St",
@@ -152,6 +220,31 @@ func TestGRPCServer(t *testing.T) {
 		}
 	})
 
+	t.Run("CreateOrder_ZoneOutsideCity", func(t *testing.T) {
+		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		zones, err := client.ListZones(ctx, &pb.ListZonesRequest{CityId: 2})
+		if err != nil || zones.Code != 200 || len(zones.Data) == 0 {
+			t.Fatalf("ListZones() = %v, %v, want the zones of city 2", zones, err)
+		}
+		resp, err := client.CreateOrder(ctx, &pb.CreateOrderRequest{
+			StoreId:          storeID,
+			RecipientName:    "John Doe",
+			RecipientPhone:   "01712345678",
+			RecipientAddress: "123 Main St",
+			RecipientCity:    1,
+			RecipientZone:    zones.Data[0].Id,
+			ItemQuantity:     1,
+			ItemWeight:       0.5,
+			AmountToCollect:  1000.0,
+		})
+		if err != nil {
+			t.Fatalf("CreateOrder failed: %v", err)
+		}
+		if resp.Code != 422 {
+			t.Errorf("CreateOrder response = %v, want code 422", resp)
+		}
+	})
+
 	t.Run("CreateOrder_Unauthorized", func(t *testing.T) {
 		resp, err := client.CreateOrder(ctx, &pb.CreateOrderRequest{
 			RecipientName:    "John Doe",
@@ -214,7 +307,7 @@ func TestGRPCServer(t *testing.T) {
 	t.Run("CreateOrder_ForCancel", func(t *testing.T) {
 		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
 		resp, err := client.CreateOrder(ctx, &pb.CreateOrderRequest{
-			StoreId:          1,
+			StoreId:          storeID,
 			RecipientName:    "John Doe",
 			RecipientPhone:   "01712345678",
 			RecipientAddress: "123 Main St",
@@ -265,7 +358,7 @@ func TestGRPCServer(t *testing.T) {
 			t.Errorf("Logout response = %v, want code 200, type success", resp)
 		}
 
-		// Verify token is blacklisted
+		// Verify token is revoked
 		_, err = client.ListOrders(ctx, &pb.ListOrdersRequest{Limit: 10, Page: 1})
 		if err == nil {
 			t.Errorf("ListOrders expected error after logout")
@@ -0,0 +1,39 @@
+// internal/adapters/idgen/sequence.go
+package idgen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+)
+
+// SequenceGenerator issues consignment IDs numbered by the PostgreSQL
+// sequence consignment_id_seq, so IDs never repeat, even across replicas.
+// Numbers used by failed inserts are skipped, not reused.
+type SequenceGenerator struct {
+	db     *sql.DB
+	format string
+}
+
+// NewSequenceGenerator returns a generator for IDs with the prefix format
+// format; see domain.ConsignmentIDPrefix.
+func NewSequenceGenerator(db *sql.DB, format string) (*SequenceGenerator, error) {
+	if _, err := domain.ConsignmentIDPrefix(format, time.Now()); err != nil {
+		return nil, err
+	}
+	return &SequenceGenerator{db: db, format: format}, nil
+}
+
+func (g *SequenceGenerator) NewConsignmentID(ctx context.Context) (string, error) {
+	var seq int64
+	if err := g.db.QueryRowContext(ctx, "SELECT nextval('consignment_id_seq')").Scan(&seq); err != nil {
+		return "", err
+	}
+	prefix, err := domain.ConsignmentIDPrefix(g.format, time.Now().UTC())
+	if err != nil {
+		return "", err
+	}
+	return domain.NewConsignmentID(prefix, seq), nil
+}
@@ -0,0 +1,51 @@
+// internal/adapters/notifier/notifier.go
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+)
+
+// LogNotifier writes notifications to the service log. It is a stand-in for
+// local development; never use it where logs are shared.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, msg domain.Notification) error {
+	log.Printf("notification to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileNotifier appends notifications as JSON lines to a file, which makes them
+// easy to pick up in local tests.
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, msg domain.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(struct {
+		SentAt time.Time `json:"sent_at"`
+		domain.Notification
+	}{SentAt: time.Now().UTC(), Notification: msg})
+}
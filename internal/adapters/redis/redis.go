@@ -36,6 +36,41 @@ func (c *Cache) Set(ctx context.Context, key string, value interface{}) error {
 	return c.client.Set(ctx, key, data, c.ttl).Err()
 }
 
+func (c *Cache) SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, key, data, ttl).Err()
+}
+
+func (c *Cache) Exists(ctx context.Context, key string) (bool, error) {
+	n, err := c.client.Exists(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Increment adds one to the counter at key and returns the new value. The ttl
+// is set when the counter is created and not extended by later increments.
+func (c *Cache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	n, err := c.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		if err := c.client.Expire(ctx, key, ttl).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	return c.client.Del(ctx, key).Err()
+}
+
 func (c *Cache) DeleteByPrefix(ctx context.Context, prefix string) error {
 	iter := c.client.Scan(ctx, 0, prefix+"*", 0).Iterator()
 	for iter.Next(ctx) {
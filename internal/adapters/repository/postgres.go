@@ -4,9 +4,13 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
 )
@@ -18,9 +22,24 @@ type PostgresRepository struct {
 func NewPostgresRepository(db *sql.DB) ports.OrderRepositoryPort {
 	return &PostgresRepository{db: db}
 }
+
+const userColumns = "id, username, password, roles, email_verified, discount_percent"
+
+func scanUser(row interface{ Scan(...interface{}) error }) (*domain.User, error) {
+	user := &domain.User{}
+	err := row.Scan(&user.ID, &user.Username, &user.Password, pq.Array(&user.Roles), &user.EmailVerified, &user.DiscountPercent)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
 func (r *PostgresRepository) CreateUser(ctx context.Context, username, hashedPassword string) (*domain.User, error) {
 	user := &domain.User{Username: username, Password: hashedPassword}
-	err := r.db.QueryRowContext(ctx, "INSERT INTO users (username, password) VALUES ($1, $2) RETURNING id", username, hashedPassword).Scan(&user.ID)
+	err := r.db.QueryRowContext(ctx, "INSERT INTO users (username, password, email_verified) VALUES ($1, $2, FALSE) RETURNING id, roles", username, hashedPassword).Scan(&user.ID, pq.Array(&user.Roles))
 	if err != nil {
 		if err.Error() == "pq: duplicate key value violates unique constraint \"users_username_key\"" {
 			return nil, errors.New("username already exists")
@@ -31,87 +50,1227 @@ func (r *PostgresRepository) CreateUser(ctx context.Context, username, hashedPas
 }
 
 func (r *PostgresRepository) FindUserByUsername(ctx context.Context, username string) (*domain.User, error) {
-	user := &domain.User{}
-	err := r.db.QueryRowContext(ctx, "SELECT id, username, password FROM users WHERE username = $1", username).Scan(&user.ID, &user.Username, &user.Password)
+	return scanUser(r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE username = $1", username))
+}
+
+func (r *PostgresRepository) FindUserByID(ctx context.Context, userID int64) (*domain.User, error) {
+	return scanUser(r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1", userID))
+}
+
+func (r *PostgresRepository) UpdateUserRoles(ctx context.Context, userID int64, roles []string) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET roles = $1 WHERE id = $2", pq.Array(roles), userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+func (r *PostgresRepository) UpdateUserPassword(ctx context.Context, userID int64, hashedPassword string) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET password = $1 WHERE id = $2", hashedPassword, userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+func (r *PostgresRepository) CreatePasswordReset(ctx context.Context, reset *domain.PasswordReset) error {
+	query := `
+		INSERT INTO password_resets (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4) RETURNING id
+	`
+	return r.db.QueryRowContext(ctx, query, reset.UserID, reset.TokenHash, reset.ExpiresAt, reset.CreatedAt).Scan(&reset.ID)
+}
+
+func (r *PostgresRepository) FindPasswordReset(ctx context.Context, tokenHash string) (*domain.PasswordReset, error) {
+	p := &domain.PasswordReset{}
+	query := "SELECT id, user_id, token_hash, expires_at, created_at, used_at FROM password_resets WHERE token_hash = $1"
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(&p.ID, &p.UserID, &p.TokenHash, &p.ExpiresAt, &p.CreatedAt, &p.UsedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return user, nil
+	return p, nil
 }
 
-func (r *PostgresRepository) CreateOrder(ctx context.Context, order *domain.Order) error {
+// UsePasswordReset atomically consumes a reset token so it works only once.
+func (r *PostgresRepository) UsePasswordReset(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE password_resets SET used_at = $2 WHERE id = $1 AND used_at IS NULL", id, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrInvalidResetToken
+	}
+	return nil
+}
+
+func (r *PostgresRepository) InvalidatePasswordResets(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE password_resets SET used_at = $2 WHERE user_id = $1 AND used_at IS NULL", userID, time.Now().UTC())
+	return err
+}
+
+func (r *PostgresRepository) MarkEmailVerified(ctx context.Context, userID int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET email_verified = TRUE WHERE id = $1", userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+func (r *PostgresRepository) CreateEmailVerification(ctx context.Context, v *domain.EmailVerification) error {
 	query := `
-		INSERT INTO orders (
-			consignment_id, created_at, description, merchant_order_id, recipient_name, recipient_address, recipient_phone,
-			order_amount, total_fee, instruction, order_type_id, cod_fee, promo_discount, discount, delivery_fee, status,
-			order_type, item_type, store_name, store_contact_phone, cod_amount, delivery_charge, user_id, store_id,
-			recipient_city, recipient_zone, recipient_area, delivery_type, item_quantity, item_weight, amount_to_collect
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)
+		INSERT INTO email_verifications (user_id, code_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4) RETURNING id
 	`
-	_, err := r.db.ExecContext(ctx, query,
-		order.ConsignmentID, order.CreatedAt, order.Description, order.MerchantOrderID, order.RecipientName, order.RecipientAddress, order.RecipientPhone,
-		order.OrderAmount, order.TotalFee, order.Instruction, order.OrderTypeID, order.CODFee, order.PromoDiscount, order.Discount, order.DeliveryFee, order.Status,
-		order.OrderType, order.ItemType, order.StoreName, order.StoreContactPhone, order.CODAmount, order.DeliveryCharge, order.UserID, order.StoreID,
-		order.RecipientCity, order.RecipientZone, order.RecipientArea, order.DeliveryType, order.ItemQuantity, order.ItemWeight, order.AmountToCollect,
-	)
+	return r.db.QueryRowContext(ctx, query, v.UserID, v.CodeHash, v.ExpiresAt, v.CreatedAt).Scan(&v.ID)
+}
+
+// FindEmailVerification returns the most recent unused verification code of a user.
+func (r *PostgresRepository) FindEmailVerification(ctx context.Context, userID int64) (*domain.EmailVerification, error) {
+	v := &domain.EmailVerification{}
+	query := `
+		SELECT id, user_id, code_hash, attempts, expires_at, created_at, used_at
+		FROM email_verifications
+		WHERE user_id = $1 AND used_at IS NULL
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&v.ID, &v.UserID, &v.CodeHash, &v.Attempts, &v.ExpiresAt, &v.CreatedAt, &v.UsedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RecordEmailVerificationAttempt counts a guess against a code and returns the
+// number of guesses made so far.
+func (r *PostgresRepository) RecordEmailVerificationAttempt(ctx context.Context, id int64) (int64, error) {
+	var attempts int64
+	err := r.db.QueryRowContext(ctx, "UPDATE email_verifications SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts", id).Scan(&attempts)
+	if err == sql.ErrNoRows {
+		return 0, domain.ErrInvalidVerification
+	}
+	return attempts, err
+}
+
+func (r *PostgresRepository) UseEmailVerification(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE email_verifications SET used_at = $2 WHERE id = $1 AND used_at IS NULL", id, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrInvalidVerification
+	}
+	return nil
+}
+
+func (r *PostgresRepository) InvalidateEmailVerifications(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE email_verifications SET used_at = $2 WHERE user_id = $1 AND used_at IS NULL", userID, time.Now().UTC())
 	return err
 }
 
-func (r *PostgresRepository) ListOrders(ctx context.Context, userID int64, limit, page int64) ([]*domain.Order, int64, error) {
-	if limit < 1 {
-		limit = 10
+func (r *PostgresRepository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id
+	`
+	return r.db.QueryRowContext(ctx, query, token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt, token.CreatedAt).Scan(&token.ID)
+}
+
+func (r *PostgresRepository) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	t := &domain.RefreshToken{}
+	query := `
+		SELECT id, user_id, family_id, token_hash, expires_at, created_at, used_at, revoked_at
+		FROM refresh_tokens WHERE token_hash = $1
+	`
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(&t.ID, &t.UserID, &t.FamilyID, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.UsedAt, &t.RevokedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	if page < 1 {
-		page = 1
+	if err != nil {
+		return nil, err
 	}
-	offset := (page - 1) * limit
+	return t, nil
+}
 
-	var total int64
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders WHERE user_id = $1", userID).Scan(&total)
+// UseRefreshToken atomically marks a refresh token as consumed. It fails with
+// domain.ErrRefreshTokenReused if the token was already used or revoked, so two
+// concurrent refreshes with the same token cannot both succeed.
+func (r *PostgresRepository) UseRefreshToken(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE refresh_tokens SET used_at = $2 WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL", id, time.Now().UTC())
 	if err != nil {
-		return nil, 0, err
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrRefreshTokenReused
+	}
+	return nil
+}
+
+func (r *PostgresRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = $2 WHERE family_id = $1 AND revoked_at IS NULL", familyID, time.Now().UTC())
+	return err
+}
+
+const apiKeyColumns = "id, user_id, store_id, name, prefix, key_hash, methods, created_at, last_used_at, revoked_at"
+
+func scanAPIKey(row interface{ Scan(...interface{}) error }) (*domain.APIKey, error) {
+	k := &domain.APIKey{}
+	err := row.Scan(&k.ID, &k.UserID, &k.StoreID, &k.Name, &k.Prefix, &k.KeyHash, pq.Array(&k.Methods), &k.CreatedAt, &k.LastUsedAt, &k.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (r *PostgresRepository) RevokeUserRefreshTokens(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL", userID, time.Now().UTC())
+	return err
+}
+
+func (r *PostgresRepository) CreateSession(ctx context.Context, session *domain.Session) error {
+	query := `
+		INSERT INTO sessions (id, user_id, device, ip, user_agent, created_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.ExecContext(ctx, query, session.ID, session.UserID, session.Device, session.IP, session.UserAgent, session.CreatedAt, session.LastSeenAt)
+	return err
+}
+
+// ListSessions returns the sessions of a user that can still be refreshed,
+// most recently seen first. Sessions whose refresh tokens were all revoked or
+// expired, for example after a password change, are left out.
+func (r *PostgresRepository) ListSessions(ctx context.Context, userID int64) ([]*domain.Session, error) {
+	query := `
+		SELECT s.id, s.user_id, s.device, s.ip, s.user_agent, s.created_at, s.last_seen_at, s.revoked_at
+		FROM sessions s
+		WHERE s.user_id = $1 AND s.revoked_at IS NULL AND EXISTS (
+			SELECT 1 FROM refresh_tokens rt
+			WHERE rt.family_id = s.id AND rt.used_at IS NULL AND rt.revoked_at IS NULL AND rt.expires_at > $2
+		)
+		ORDER BY s.last_seen_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		s := &domain.Session{}
+		if err := rows.Scan(&s.ID, &s.UserID, &s.Device, &s.IP, &s.UserAgent, &s.CreatedAt, &s.LastSeenAt, &s.RevokedAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *PostgresRepository) TouchSession(ctx context.Context, id, ip string, seenAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE sessions SET ip = $1, last_seen_at = $2 WHERE id = $3", ip, seenAt, id)
+	return err
+}
+
+func (r *PostgresRepository) RevokeSession(ctx context.Context, id string, userID int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE sessions SET revoked_at = $3 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL", id, userID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrSessionNotFound
+	}
+	return nil
+}
+
+func (r *PostgresRepository) FindTwoFactor(ctx context.Context, userID int64) (*domain.TwoFactor, error) {
+	tf := &domain.TwoFactor{}
+	query := "SELECT user_id, secret, last_step, created_at, enabled_at FROM two_factor WHERE user_id = $1"
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&tf.UserID, &tf.Secret, &tf.LastStep, &tf.CreatedAt, &tf.EnabledAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	return tf, nil
+}
+
+// SaveTwoFactor stores a pending enrollment, replacing an earlier pending one.
+// An enabled enrollment is never overwritten.
+func (r *PostgresRepository) SaveTwoFactor(ctx context.Context, tf *domain.TwoFactor) error {
 	query := `
-		SELECT consignment_id, created_at, description, merchant_order_id, recipient_name, recipient_address, recipient_phone,
-			order_amount, total_fee, instruction, order_type_id, cod_fee, promo_discount, discount, delivery_fee, status,
-			order_type, item_type, store_name, store_contact_phone, cod_amount, delivery_charge, store_id,
-			recipient_city, recipient_zone, recipient_area, delivery_type, item_quantity, item_weight, amount_to_collect
-		FROM orders WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3
+		INSERT INTO two_factor (user_id, secret, last_step, created_at)
+		VALUES ($1, $2, 0, $3)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, last_step = 0, created_at = EXCLUDED.created_at
+		WHERE two_factor.enabled_at IS NULL
 	`
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	res, err := r.db.ExecContext(ctx, query, tf.UserID, tf.Secret, tf.CreatedAt)
 	if err != nil {
-		return nil, 0, err
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrTwoFactorEnabled
+	}
+	return nil
+}
+
+// EnableTwoFactor confirms a pending enrollment and stores its first recovery
+// codes.
+func (r *PostgresRepository) EnableTwoFactor(ctx context.Context, userID, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "UPDATE two_factor SET enabled_at = $3, last_step = $1 WHERE user_id = $2 AND enabled_at IS NULL", step, userID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrTwoFactorEnabled
+	}
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UseTwoFactorStep records that the code of a TOTP time step was accepted. It
+// fails with domain.ErrInvalidTwoFactor if that step or a later one was
+// already used, so a code cannot be replayed.
+func (r *PostgresRepository) UseTwoFactorStep(ctx context.Context, userID, step int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE two_factor SET last_step = $1 WHERE user_id = $2 AND last_step < $1", step, userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrInvalidTwoFactor
+	}
+	return nil
+}
+
+func (r *PostgresRepository) DeleteTwoFactor(ctx context.Context, userID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM two_factor WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID int64, codeHashes []string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	query := "INSERT INTO recovery_codes (user_id, code_hash, created_at) SELECT $1, unnest($2::text[]), $3"
+	_, err := tx.ExecContext(ctx, query, userID, pq.Array(codeHashes), time.Now().UTC())
+	return err
+}
+
+// UseRecoveryCode atomically consumes a recovery code. It fails with
+// domain.ErrInvalidTwoFactor if the code is unknown or was already used.
+func (r *PostgresRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE recovery_codes SET used_at = $3 WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL", userID, codeHash, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrInvalidTwoFactor
+	}
+	return nil
+}
+
+func (r *PostgresRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	query := `
+		INSERT INTO api_keys (user_id, store_id, name, prefix, key_hash, methods, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id
+	`
+	return r.db.QueryRowContext(ctx, query, key.UserID, key.StoreID, key.Name, key.Prefix, key.KeyHash, pq.Array(key.Methods), key.CreatedAt).Scan(&key.ID)
+}
+
+func (r *PostgresRepository) FindAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	k, err := scanAPIKey(r.db.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE prefix = $1", prefix))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return k, err
+}
+
+func (r *PostgresRepository) ListAPIKeys(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	if err != nil {
+		return nil, err
 	}
 	defer rows.Close()
 
-	var orders []*domain.Order
+	var keys []*domain.APIKey
 	for rows.Next() {
-		o := &domain.Order{UserID: userID}
-		err := rows.Scan(
-			&o.ConsignmentID, &o.CreatedAt, &o.Description, &o.MerchantOrderID, &o.RecipientName, &o.RecipientAddress, &o.RecipientPhone,
-			&o.OrderAmount, &o.TotalFee, &o.Instruction, &o.OrderTypeID, &o.CODFee, &o.PromoDiscount, &o.Discount, &o.DeliveryFee, &o.Status,
-			&o.OrderType, &o.ItemType, &o.StoreName, &o.StoreContactPhone, &o.CODAmount, &o.DeliveryCharge, &o.StoreID,
-			&o.RecipientCity, &o.RecipientZone, &o.RecipientArea, &o.DeliveryType, &o.ItemQuantity, &o.ItemWeight, &o.AmountToCollect,
-		)
+		k, err := scanAPIKey(rows)
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
-		orders = append(orders, o)
+		keys = append(keys, k)
 	}
-	return orders, total, nil
+	return keys, rows.Err()
 }
 
-func (r *PostgresRepository) CancelOrder(ctx context.Context, consignmentID string, userID int64) error {
-	res, err := r.db.ExecContext(ctx, "UPDATE orders SET status = 'Cancelled' WHERE consignment_id = $1 AND user_id = $2 AND status = 'Pending'", consignmentID, userID)
+func (r *PostgresRepository) RevokeAPIKey(ctx context.Context, id, userID int64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE api_keys SET revoked_at = $3 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL", id, userID, time.Now().UTC())
 	if err != nil {
 		return err
 	}
 	rows, _ := res.RowsAffected()
 	if rows == 0 {
-		return errors.New("order not found, unauthorized, or cannot cancel")
+		return errors.New("api key not found or already revoked")
 	}
 	return nil
 }
+
+func (r *PostgresRepository) TouchAPIKey(ctx context.Context, id int64, usedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE api_keys SET last_used_at = $1 WHERE id = $2", usedAt, id)
+	return err
+}
+
+func (r *PostgresRepository) CreateOrder(ctx context.Context, order *domain.Order) error {
+	return r.CreateOrders(ctx, []*domain.Order{order})
+}
+
+// CreateOrders inserts orders in one transaction, so either all of them are
+// created or none.
+func (r *PostgresRepository) CreateOrders(ctx context.Context, orders []*domain.Order) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, order := range orders {
+		if err := insertOrder(ctx, tx, order); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// CreateOrderWithIdempotencyKey inserts order together with the idempotency
+// key of the request that created it. A key created before expiredBefore is
+// replaced; any other existing key of the user fails with
+// ErrIdempotencyKeyExists and no order is created.
+func (r *PostgresRepository) CreateOrderWithIdempotencyKey(ctx context.Context, order *domain.Order, key *domain.IdempotencyKey, expiredBefore time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A concurrent request with the same key blocks here until the first one
+	// commits, and then finds the key taken
+	query := `
+		INSERT INTO idempotency_keys (user_id, idempotency_key, request_hash, response, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, response = EXCLUDED.response, created_at = EXCLUDED.created_at
+		WHERE idempotency_keys.created_at < $6
+	`
+	res, err := tx.ExecContext(ctx, query, key.UserID, key.Key, key.RequestHash, key.Response, key.CreatedAt, expiredBefore)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return domain.ErrIdempotencyKeyExists
+	}
+	if err := insertOrder(ctx, tx, order); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// FindIdempotencyKey returns the key of the user created at or after
+// expiredBefore, or nil if there is none.
+func (r *PostgresRepository) FindIdempotencyKey(ctx context.Context, userID int64, key string, expiredBefore time.Time) (*domain.IdempotencyKey, error) {
+	k := &domain.IdempotencyKey{}
+	query := `
+		SELECT user_id, idempotency_key, request_hash, response, created_at FROM idempotency_keys
+		WHERE user_id = $1 AND idempotency_key = $2 AND created_at >= $3
+	`
+	err := r.db.QueryRowContext(ctx, query, userID, key, expiredBefore).Scan(&k.UserID, &k.Key, &k.RequestHash, &k.Response, &k.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// DeleteIdempotencyKeys deletes the keys created before expiredBefore and
+// returns how many it deleted.
+func (r *PostgresRepository) DeleteIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM idempotency_keys WHERE created_at < $1", expiredBefore)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// merchantOrderIndex is the optional unique index on the merchant order IDs
+// of a store, see initDB.
+const merchantOrderIndex = "idx_orders_store_merchant_order_id"
+
+func insertOrder(ctx context.Context, tx *sql.Tx, order *domain.Order) error {
+	query := `
+		INSERT INTO orders (
+			consignment_id, created_at, description, merchant_order_id, recipient_name, recipient_address, recipient_phone,
+			order_amount, total_fee, instruction, order_type_id, cod_fee, promo_discount, discount, delivery_fee, status,
+			order_type, item_type, store_name, store_contact_phone, cod_amount, delivery_charge, user_id, store_id,
+			recipient_city, recipient_zone, recipient_area, delivery_type, item_quantity, item_weight, amount_to_collect, rate_card_version,
+			promo_code
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33)
+	`
+	_, err := tx.ExecContext(ctx, query,
+		order.ConsignmentID, order.CreatedAt, order.Description, order.MerchantOrderID, order.RecipientName, order.RecipientAddress, order.RecipientPhone,
+		order.OrderAmount, order.TotalFee, order.Instruction, order.OrderTypeID, order.CODFee, order.PromoDiscount, order.Discount, order.DeliveryFee, order.Status,
+		order.OrderType, order.ItemType, order.StoreName, order.StoreContactPhone, order.CODAmount, order.DeliveryCharge, order.UserID, order.StoreID,
+		order.RecipientCity, order.RecipientZone, order.RecipientArea, order.DeliveryType, order.ItemQuantity, order.ItemWeight, order.AmountToCollect, order.RateCardVersion,
+		order.PromoCode,
+	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == merchantOrderIndex {
+		return domain.ErrDuplicateMerchantOrder
+	}
+	if err != nil {
+		return err
+	}
+	if order.PromoCode != "" {
+		if err := redeemPromotion(ctx, tx, order); err != nil {
+			return err
+		}
+	}
+	// The first history entry marks the creation of the order
+	return insertOrderStatusEvent(ctx, tx, &domain.OrderStatusEvent{
+		ConsignmentID: order.ConsignmentID,
+		ToStatus:      order.Status,
+		ActorID:       order.UserID,
+		Reason:        "order created",
+		CreatedAt:     order.CreatedAt,
+	})
+}
+
+const orderColumns = `consignment_id, created_at, description, merchant_order_id, recipient_name, recipient_address, recipient_phone,
+	order_amount, total_fee, instruction, order_type_id, cod_fee, promo_discount, discount, delivery_fee, status,
+	order_type, item_type, store_name, store_contact_phone, cod_amount, delivery_charge, user_id, store_id,
+	recipient_city, recipient_zone, recipient_area, delivery_type, item_quantity, item_weight, amount_to_collect, archived_at, rate_card_version,
+	promo_code`
+
+func scanOrder(row interface{ Scan(...interface{}) error }) (*domain.Order, error) {
+	o := &domain.Order{}
+	err := row.Scan(
+		&o.ConsignmentID, &o.CreatedAt, &o.Description, &o.MerchantOrderID, &o.RecipientName, &o.RecipientAddress, &o.RecipientPhone,
+		&o.OrderAmount, &o.TotalFee, &o.Instruction, &o.OrderTypeID, &o.CODFee, &o.PromoDiscount, &o.Discount, &o.DeliveryFee, &o.Status,
+		&o.OrderType, &o.ItemType, &o.StoreName, &o.StoreContactPhone, &o.CODAmount, &o.DeliveryCharge, &o.UserID, &o.StoreID,
+		&o.RecipientCity, &o.RecipientZone, &o.RecipientArea, &o.DeliveryType, &o.ItemQuantity, &o.ItemWeight, &o.AmountToCollect, &o.ArchivedAt, &o.RateCardVersion,
+		&o.PromoCode,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// orderSorts maps the sort orders of domain.OrderFilter to ORDER BY clauses.
+var orderSorts = map[string]string{
+	domain.OrderSortCreatedDesc: "created_at DESC, consignment_id DESC",
+	domain.OrderSortCreatedAsc:  "created_at ASC, consignment_id ASC",
+	domain.OrderSortAmountDesc:  "order_amount DESC, consignment_id DESC",
+	domain.OrderSortAmountAsc:   "order_amount ASC, consignment_id ASC",
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// orderConditions builds the WHERE clause and its arguments for the orders of
+// userID that match filter.
+func orderConditions(userID int64, filter domain.OrderFilter) (string, []interface{}) {
+	conds := []string{"user_id = $1"}
+	args := []interface{}{userID}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.Archived {
+		conds = append(conds, "archived_at IS NOT NULL")
+	} else {
+		conds = append(conds, "archived_at IS NULL")
+	}
+	if len(filter.Statuses) > 0 {
+		add("status = ANY($%d)", pq.Array(filter.Statuses))
+	}
+	switch filter.Stage {
+	case domain.OrderStageActive:
+		add("status <> ALL($%d)", pq.Array(domain.FinalOrderStatuses()))
+	case domain.OrderStageFinal:
+		add("status = ANY($%d)", pq.Array(domain.FinalOrderStatuses()))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		add("created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		add("created_at < $%d", filter.CreatedTo)
+	}
+	if filter.RecipientCity != 0 {
+		add("recipient_city = $%d", filter.RecipientCity)
+	}
+	if filter.RecipientZone != 0 {
+		add("recipient_zone = $%d", filter.RecipientZone)
+	}
+	if filter.StoreID != 0 {
+		add("store_id = $%d", filter.StoreID)
+	}
+	if filter.MerchantOrderID != "" {
+		add("merchant_order_id = $%d", filter.MerchantOrderID)
+	}
+	if filter.Search != "" {
+		add("(recipient_name ILIKE $%[1]d OR recipient_phone LIKE $%[1]d)", "%"+likeEscaper.Replace(filter.Search)+"%")
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+func (r *PostgresRepository) ListOrders(ctx context.Context, userID int64, filter domain.OrderFilter, limit, page int64) ([]*domain.Order, int64, error) {
+	if limit < 1 {
+		limit = 10
+	}
+	if page < 1 {
+		page = 1
+	}
+	offset := (page - 1) * limit
+	orderBy, ok := orderSorts[filter.Sort]
+	if !ok {
+		orderBy = orderSorts[domain.OrderSortCreatedDesc]
+	}
+	total, err := r.CountOrders(ctx, userID, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	where, args := orderConditions(userID, filter)
+	query := fmt.Sprintf("SELECT %s FROM orders WHERE %s ORDER BY %s LIMIT $%d OFFSET $%d", orderColumns, where, orderBy, len(args)+1, len(args)+2)
+	orders, err := r.queryOrders(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return orders, total, nil
+}
+
+// ListOrdersAfter returns up to limit orders that come after the cursor in
+// the filter's sort order, which has to be by creation time. A nil cursor
+// starts at the beginning.
+func (r *PostgresRepository) ListOrdersAfter(ctx context.Context, userID int64, filter domain.OrderFilter, after *domain.OrderCursor, limit int64) ([]*domain.Order, error) {
+	if !filter.Keyset() {
+		return nil, fmt.Errorf("%w: sort %q does not support page tokens", domain.ErrInvalidOrderFilter, filter.Sort)
+	}
+	where, args := orderConditions(userID, filter)
+	cmp := "<"
+	if filter.Sort == domain.OrderSortCreatedAsc {
+		cmp = ">"
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ConsignmentID)
+		where += fmt.Sprintf(" AND (created_at, consignment_id) %s ($%d, $%d)", cmp, len(args)-1, len(args))
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf("SELECT %s FROM orders WHERE %s ORDER BY %s LIMIT $%d", orderColumns, where, orderSorts[filter.Sort], len(args))
+	return r.queryOrders(ctx, query, args...)
+}
+
+func (r *PostgresRepository) CountOrders(ctx context.Context, userID int64, filter domain.OrderFilter) (int64, error) {
+	where, args := orderConditions(userID, filter)
+	var total int64
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders WHERE "+where, args...).Scan(&total)
+	return total, err
+}
+
+func (r *PostgresRepository) queryOrders(ctx context.Context, query string, args ...interface{}) ([]*domain.Order, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orders []*domain.Order
+	for rows.Next() {
+		o, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	return orders, rows.Err()
+}
+
+func (r *PostgresRepository) FindOrder(ctx context.Context, consignmentID string) (*domain.Order, error) {
+	o, err := scanOrder(r.db.QueryRowContext(ctx, "SELECT "+orderColumns+" FROM orders WHERE consignment_id = $1", consignmentID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return o, err
+}
+
+// UpdateOrderStatus moves an order from event.FromStatus to event.ToStatus and
+// records the change in its history. It fails with ErrOrderStatusConflict if
+// the order is no longer in event.FromStatus.
+func (r *PostgresRepository) UpdateOrderStatus(ctx context.Context, event *domain.OrderStatusEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "UPDATE orders SET status = $1 WHERE consignment_id = $2 AND status = $3", event.ToStatus, event.ConsignmentID, event.FromStatus)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return domain.ErrOrderStatusConflict
+	}
+	if err := insertOrderStatusEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UpdateOrder stores the edited details and fees of a pending order together
+// with the history event of the edit. It fails with ErrOrderNotEditable if
+// the order is no longer pending.
+func (r *PostgresRepository) UpdateOrder(ctx context.Context, order *domain.Order, event *domain.OrderStatusEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE orders SET
+			recipient_name = $1, recipient_phone = $2, recipient_address = $3, recipient_city = $4, recipient_zone = $5,
+			recipient_area = $6, delivery_type = $7, item_type = $8, instruction = $9, item_quantity = $10, item_weight = $11,
+			amount_to_collect = $12, description = $13, order_amount = $14, cod_amount = $15, delivery_fee = $16,
+			delivery_charge = $17, cod_fee = $18, discount = $19, promo_discount = $20, total_fee = $21, rate_card_version = $22
+		WHERE consignment_id = $23 AND status = $24
+	`
+	res, err := tx.ExecContext(ctx, query,
+		order.RecipientName, order.RecipientPhone, order.RecipientAddress, order.RecipientCity, order.RecipientZone,
+		order.RecipientArea, order.DeliveryType, order.ItemType, order.Instruction, order.ItemQuantity, order.ItemWeight,
+		order.AmountToCollect, order.Description, order.OrderAmount, order.CODAmount, order.DeliveryFee,
+		order.DeliveryCharge, order.CODFee, order.Discount, order.PromoDiscount, order.TotalFee, order.RateCardVersion,
+		order.ConsignmentID, domain.OrderStatusPending,
+	)
+	if err != nil {
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return domain.ErrOrderNotEditable
+	}
+	if order.PromoCode != "" {
+		_, err := tx.ExecContext(ctx, "UPDATE promotion_redemptions SET discount = $1 WHERE consignment_id = $2", order.PromoDiscount, order.ConsignmentID)
+		if err != nil {
+			return err
+		}
+	}
+	if err := insertOrderStatusEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func insertOrderStatusEvent(ctx context.Context, tx *sql.Tx, event *domain.OrderStatusEvent) error {
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO order_status_history (consignment_id, from_status, to_status, actor_id, reason, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id
+	`
+	return tx.QueryRowContext(ctx, query, event.ConsignmentID, event.FromStatus, event.ToStatus, event.ActorID, event.Reason, changes, event.CreatedAt).Scan(&event.ID)
+}
+
+// ListOrderStatusHistory returns the status changes of an order, oldest first.
+func (r *PostgresRepository) ListOrderStatusHistory(ctx context.Context, consignmentID string) ([]*domain.OrderStatusEvent, error) {
+	query := `
+		SELECT id, consignment_id, from_status, to_status, actor_id, reason, changes, created_at
+		FROM order_status_history WHERE consignment_id = $1 ORDER BY created_at, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, consignmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domain.OrderStatusEvent
+	for rows.Next() {
+		e := &domain.OrderStatusEvent{}
+		var changes []byte
+		if err := rows.Scan(&e.ID, &e.ConsignmentID, &e.FromStatus, &e.ToStatus, &e.ActorID, &e.Reason, &changes, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(changes, &e.Changes); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// ArchiveOrders archives the given orders of userID that are in a final
+// status and not archived yet, and returns their consignment IDs.
+func (r *PostgresRepository) ArchiveOrders(ctx context.Context, userID int64, consignmentIDs []string, at time.Time) ([]string, error) {
+	query := `
+		UPDATE orders SET archived_at = $1
+		WHERE user_id = $2 AND consignment_id = ANY($3) AND status = ANY($4) AND archived_at IS NULL
+		RETURNING consignment_id
+	`
+	return r.queryStrings(ctx, query, at, userID, pq.Array(consignmentIDs), pq.Array(domain.FinalOrderStatuses()))
+}
+
+// UnarchiveOrders restores the given archived orders of userID and returns
+// their consignment IDs.
+func (r *PostgresRepository) UnarchiveOrders(ctx context.Context, userID int64, consignmentIDs []string) ([]string, error) {
+	query := `
+		UPDATE orders SET archived_at = NULL
+		WHERE user_id = $1 AND consignment_id = ANY($2) AND archived_at IS NOT NULL
+		RETURNING consignment_id
+	`
+	return r.queryStrings(ctx, query, userID, pq.Array(consignmentIDs))
+}
+
+// ArchiveFinalOrders archives every order in a final status that was created
+// and last changed its status before finalBefore. It returns the owner of each
+// archived order.
+func (r *PostgresRepository) ArchiveFinalOrders(ctx context.Context, finalBefore, at time.Time) ([]int64, error) {
+	query := `
+		UPDATE orders o SET archived_at = $1
+		WHERE o.archived_at IS NULL AND o.status = ANY($2) AND o.created_at < $3
+			AND NOT EXISTS (
+				SELECT 1 FROM order_status_history h WHERE h.consignment_id = o.consignment_id AND h.created_at >= $3
+			)
+		RETURNING o.user_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, at, pq.Array(domain.FinalOrderStatuses()), finalBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, rows.Err()
+}
+
+func (r *PostgresRepository) queryStrings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
+
+// CreateRateCard stores a rate card with its rates and sets its version.
+func (r *PostgresRepository) CreateRateCard(ctx context.Context, card *domain.RateCard) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO rate_cards (effective_from, cod_percent, min_cod_fee, min_delivery_fee, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING version
+	`
+	var version int64
+	err = tx.QueryRowContext(ctx, query, card.EffectiveFrom, card.CODPercent, card.MinCODFee, card.MinDeliveryFee, card.CreatedBy, card.CreatedAt).Scan(&version)
+	if err != nil {
+		return err
+	}
+	query = `
+		INSERT INTO rate_card_rates (rate_card_version, city, zone, area, delivery_type, item_type, base_fee, weight_bands, overweight_fee, extra_kg_fee)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	for _, rate := range card.Rates {
+		bands, err := json.Marshal(rate.WeightBands)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, query, version, rate.City, rate.Zone, rate.Area, rate.DeliveryType, rate.ItemType, rate.BaseFee, bands, rate.OverweightFee, rate.ExtraKgFee)
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	card.Version = version
+	return nil
+}
+
+// ListRateCards returns all rate cards with their rates, oldest version first.
+func (r *PostgresRepository) ListRateCards(ctx context.Context) ([]*domain.RateCard, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT version, effective_from, cod_percent, min_cod_fee, min_delivery_fee, created_by, created_at FROM rate_cards ORDER BY version")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cards []*domain.RateCard
+	byVersion := make(map[int64]*domain.RateCard)
+	for rows.Next() {
+		c := &domain.RateCard{}
+		if err := rows.Scan(&c.Version, &c.EffectiveFrom, &c.CODPercent, &c.MinCODFee, &c.MinDeliveryFee, &c.CreatedBy, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+		byVersion[c.Version] = c
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rateRows, err := r.db.QueryContext(ctx, "SELECT rate_card_version, city, zone, area, delivery_type, item_type, base_fee, weight_bands, overweight_fee, extra_kg_fee FROM rate_card_rates ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rateRows.Close()
+	for rateRows.Next() {
+		var version int64
+		var bands []byte
+		var rate domain.Rate
+		if err := rateRows.Scan(&version, &rate.City, &rate.Zone, &rate.Area, &rate.DeliveryType, &rate.ItemType, &rate.BaseFee, &bands, &rate.OverweightFee, &rate.ExtraKgFee); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(bands, &rate.WeightBands); err != nil {
+			return nil, err
+		}
+		if c := byVersion[version]; c != nil {
+			c.Rates = append(c.Rates, rate)
+		}
+	}
+	return cards, rateRows.Err()
+}
+
+// redeemPromotion records the use of the order's promo code. Counting the use
+// locks the promotion until the transaction ends, so concurrent orders cannot
+// exceed its caps.
+func redeemPromotion(ctx context.Context, tx *sql.Tx, order *domain.Order) error {
+	query := `
+		UPDATE promotions SET redemptions = redemptions + 1
+		WHERE code = $1 AND (max_redemptions = 0 OR redemptions < max_redemptions)
+		RETURNING id, max_per_merchant
+	`
+	var id, maxPerMerchant int64
+	err := tx.QueryRowContext(ctx, query, order.PromoCode).Scan(&id, &maxPerMerchant)
+	if err == sql.ErrNoRows {
+		return domain.ErrPromoCodeExhausted
+	}
+	if err != nil {
+		return err
+	}
+	if maxPerMerchant > 0 {
+		var used int64
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM promotion_redemptions WHERE promotion_id = $1 AND user_id = $2", id, order.UserID).Scan(&used)
+		if err != nil {
+			return err
+		}
+		if used >= maxPerMerchant {
+			return domain.ErrPromoCodeExhausted
+		}
+	}
+	query = `
+		INSERT INTO promotion_redemptions (promotion_id, user_id, consignment_id, discount, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err = tx.ExecContext(ctx, query, id, order.UserID, order.ConsignmentID, order.PromoDiscount, order.CreatedAt)
+	return err
+}
+
+func (r *PostgresRepository) UpdateUserDiscount(ctx context.Context, userID int64, percent float64) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET discount_percent = $1 WHERE id = $2", percent, userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+const promotionColumns = `id, code, kind, value, amount, max_discount, starts_at, ends_at, max_redemptions, max_per_merchant,
+	merchant_id, cities, delivery_types, redemptions, created_by, created_at`
+
+func scanPromotion(row interface{ Scan(...interface{}) error }) (*domain.Promotion, error) {
+	p := &domain.Promotion{}
+	err := row.Scan(&p.ID, &p.Code, &p.Kind, &p.Value, &p.Amount, &p.MaxDiscount, &p.StartsAt, &p.EndsAt, &p.MaxRedemptions, &p.MaxPerMerchant,
+		&p.MerchantID, pq.Array(&p.Cities), pq.Array(&p.DeliveryTypes), &p.Redemptions, &p.CreatedBy, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *PostgresRepository) CreatePromotion(ctx context.Context, promo *domain.Promotion) error {
+	query := `
+		INSERT INTO promotions (code, kind, value, amount, max_discount, starts_at, ends_at, max_redemptions, max_per_merchant,
+			merchant_id, cities, delivery_types, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query, promo.Code, promo.Kind, promo.Value, promo.Amount, promo.MaxDiscount, promo.StartsAt, promo.EndsAt,
+		promo.MaxRedemptions, promo.MaxPerMerchant, promo.MerchantID, pq.Array(promo.Cities), pq.Array(promo.DeliveryTypes),
+		promo.CreatedBy, promo.CreatedAt).Scan(&promo.ID)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return fmt.Errorf("%w: code %s already exists", domain.ErrInvalidPromotion, promo.Code)
+	}
+	return err
+}
+
+func (r *PostgresRepository) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+promotionColumns+" FROM promotions ORDER BY created_at DESC, id DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var promos []*domain.Promotion
+	for rows.Next() {
+		p, err := scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+		promos = append(promos, p)
+	}
+	return promos, rows.Err()
+}
+
+func (r *PostgresRepository) FindPromotionByCode(ctx context.Context, code string) (*domain.Promotion, error) {
+	p, err := scanPromotion(r.db.QueryRowContext(ctx, "SELECT "+promotionColumns+" FROM promotions WHERE code = $1", code))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return p, err
+}
+
+func (r *PostgresRepository) CountPromotionRedemptions(ctx context.Context, promotionID, userID int64) (int64, error) {
+	var n int64
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM promotion_redemptions WHERE promotion_id = $1 AND user_id = $2", promotionID, userID).Scan(&n)
+	return n, err
+}
+
+const storeColumns = "id, user_id, name, contact_phone, pickup_address, default_delivery_type, created_at, updated_at"
+
+func scanStore(row interface{ Scan(...interface{}) error }) (*domain.Store, error) {
+	s := &domain.Store{}
+	err := row.Scan(&s.ID, &s.UserID, &s.Name, &s.ContactPhone, &s.PickupAddress, &s.DefaultDeliveryType, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *PostgresRepository) CreateStore(ctx context.Context, store *domain.Store) error {
+	query := `
+		INSERT INTO stores (user_id, name, contact_phone, pickup_address, default_delivery_type, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id
+	`
+	return r.db.QueryRowContext(ctx, query, store.UserID, store.Name, store.ContactPhone, store.PickupAddress,
+		store.DefaultDeliveryType, store.CreatedAt, store.UpdatedAt).Scan(&store.ID)
+}
+
+// UpdateStore saves the details of a store. It fails with
+// domain.ErrStoreNotFound unless the store belongs to store.UserID.
+func (r *PostgresRepository) UpdateStore(ctx context.Context, store *domain.Store) error {
+	query := `
+		UPDATE stores SET name = $1, contact_phone = $2, pickup_address = $3, default_delivery_type = $4, updated_at = $5
+		WHERE id = $6 AND user_id = $7 RETURNING created_at
+	`
+	err := r.db.QueryRowContext(ctx, query, store.Name, store.ContactPhone, store.PickupAddress, store.DefaultDeliveryType,
+		store.UpdatedAt, store.ID, store.UserID).Scan(&store.CreatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrStoreNotFound
+	}
+	return err
+}
+
+func (r *PostgresRepository) FindStore(ctx context.Context, id int64) (*domain.Store, error) {
+	s, err := scanStore(r.db.QueryRowContext(ctx, "SELECT "+storeColumns+" FROM stores WHERE id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return s, err
+}
+
+func (r *PostgresRepository) ListStores(ctx context.Context, userID int64) ([]*domain.Store, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+storeColumns+" FROM stores WHERE user_id = $1 ORDER BY created_at, id", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var stores []*domain.Store
+	for rows.Next() {
+		s, err := scanStore(rows)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, s)
+	}
+	return stores, rows.Err()
+}
+
+// SaveLocations adds the locations of catalog that are not stored yet and
+// updates the others. Locations missing from catalog are kept, since orders
+// may refer to them.
+func (r *PostgresRepository) SaveLocations(ctx context.Context, catalog *domain.LocationCatalog) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, c := range catalog.Cities {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO cities (id, name) VALUES ($1, $2)
+			ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name`, c.ID, c.Name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, z := range catalog.Zones {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO zones (id, city_id, name) VALUES ($1, $2, $3)
+			ON CONFLICT (id) DO UPDATE SET city_id = EXCLUDED.city_id, name = EXCLUDED.name`, z.ID, z.CityID, z.Name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, a := range catalog.Areas {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO areas (id, zone_id, name, serviceable) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (id) DO UPDATE SET zone_id = EXCLUDED.zone_id, name = EXCLUDED.name, serviceable = EXCLUDED.serviceable`,
+			a.ID, a.ZoneID, a.Name, a.Serviceable)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ListLocations returns the whole location catalog, each level sorted by name.
+func (r *PostgresRepository) ListLocations(ctx context.Context) (*domain.LocationCatalog, error) {
+	catalog := &domain.LocationCatalog{}
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name FROM cities ORDER BY name, id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c domain.City
+		if err := rows.Scan(&c.ID, &c.Name); err != nil {
+			return nil, err
+		}
+		catalog.Cities = append(catalog.Cities, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	zoneRows, err := r.db.QueryContext(ctx, "SELECT id, city_id, name FROM zones ORDER BY name, id")
+	if err != nil {
+		return nil, err
+	}
+	defer zoneRows.Close()
+	for zoneRows.Next() {
+		var z domain.Zone
+		if err := zoneRows.Scan(&z.ID, &z.CityID, &z.Name); err != nil {
+			return nil, err
+		}
+		catalog.Zones = append(catalog.Zones, z)
+	}
+	if err := zoneRows.Err(); err != nil {
+		return nil, err
+	}
+
+	areaRows, err := r.db.QueryContext(ctx, "SELECT id, zone_id, name, serviceable FROM areas ORDER BY name, id")
+	if err != nil {
+		return nil, err
+	}
+	defer areaRows.Close()
+	for areaRows.Next() {
+		var a domain.Area
+		if err := areaRows.Scan(&a.ID, &a.ZoneID, &a.Name, &a.Serviceable); err != nil {
+			return nil, err
+		}
+		catalog.Areas = append(catalog.Areas, a)
+	}
+	return catalog, areaRows.Err()
+}
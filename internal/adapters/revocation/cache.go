@@ -0,0 +1,66 @@
+// internal/adapters/revocation/cache.go
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+const keyPrefix = "revoked:"
+
+// CacheStore keeps revocations in the shared cache (Redis), so a token revoked
+// on one replica is rejected by all of them and survives restarts.
+type CacheStore struct {
+	cache ports.CachePort
+}
+
+func NewCacheStore(cache ports.CachePort) *CacheStore {
+	return &CacheStore{cache: cache}
+}
+
+func (s *CacheStore) Revoke(ctx context.Context, id string, until time.Time) error {
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		// Already expired, the token is rejected on its own
+		return nil
+	}
+	return s.cache.SetWithTTL(ctx, keyPrefix+id, until.Unix(), ttl)
+}
+
+func (s *CacheStore) IsRevoked(ctx context.Context, id string) (bool, error) {
+	return s.cache.Exists(ctx, keyPrefix+id)
+}
+
+func (s *CacheStore) RevokeUser(ctx context.Context, userID int64, issuedBefore, until time.Time) error {
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+	return s.cache.SetWithTTL(ctx, userKey(userID), issuedBefore.UnixMilli(), ttl)
+}
+
+func (s *CacheStore) UserRevokedBefore(ctx context.Context, userID int64) (time.Time, error) {
+	key := userKey(userID)
+	ok, err := s.cache.Exists(ctx, key)
+	if err != nil || !ok {
+		return time.Time{}, err
+	}
+	data, err := s.cache.Get(ctx, key)
+	if err != nil {
+		// Expired between the two calls
+		return time.Time{}, nil
+	}
+	var ms int64
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
+}
+
+func userKey(userID int64) string {
+	return fmt.Sprintf("%suser:%d", keyPrefix, userID)
+}
@@ -0,0 +1,80 @@
+// internal/adapters/revocation/memory.go
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a process-local fallback for running without Redis. Its
+// revocations are lost on restart and not shared between replicas.
+type MemoryStore struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+	users   map[int64]userRevocation
+}
+
+type userRevocation struct {
+	issuedBefore time.Time
+	until        time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{revoked: make(map[string]time.Time), users: make(map[int64]userRevocation)}
+}
+
+func (s *MemoryStore) Revoke(ctx context.Context, id string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if !until.After(now) {
+		return nil
+	}
+	s.revoked[id] = until
+	// Drop entries whose tokens have expired so the map does not grow forever
+	for k, exp := range s.revoked {
+		if !exp.After(now) {
+			delete(s.revoked, k)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) IsRevoked(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	until, ok := s.revoked[id]
+	if !ok {
+		return false, nil
+	}
+	if !until.After(time.Now()) {
+		delete(s.revoked, id)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (s *MemoryStore) RevokeUser(ctx context.Context, userID int64, issuedBefore, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !until.After(time.Now()) {
+		return nil
+	}
+	s.users[userID] = userRevocation{issuedBefore: issuedBefore, until: until}
+	return nil
+}
+
+func (s *MemoryStore) UserRevokedBefore(ctx context.Context, userID int64) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.users[userID]
+	if !ok {
+		return time.Time{}, nil
+	}
+	if !r.until.After(time.Now()) {
+		delete(s.users, userID)
+		return time.Time{}, nil
+	}
+	return r.issuedBefore, nil
+}
@@ -0,0 +1,102 @@
+// internal/application/api_key_service.go
+package application
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+// lastUsedResolution limits how often a busy key's last_used_at is written.
+const lastUsedResolution = time.Minute
+
+type APIKeyService struct {
+	repo ports.OrderRepositoryPort
+}
+
+func NewAPIKeyService(repo ports.OrderRepositoryPort) *APIKeyService {
+	return &APIKeyService{repo: repo}
+}
+
+// CreateAPIKey issues a new key for the user, limited to one of their stores
+// if storeID is not 0. The plaintext key is returned only here and cannot be
+// recovered later.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, userID int64, name string, storeID int64, methods []string) (string, *domain.APIKey, error) {
+	if name == "" {
+		return "", nil, errors.New("name is required")
+	}
+	if len(methods) == 0 {
+		return "", nil, errors.New("at least one method is required")
+	}
+	if storeID != 0 {
+		if _, err := orderStore(ctx, s.repo, storeID, userID); err != nil {
+			return "", nil, err
+		}
+	}
+	key, prefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		return "", nil, err
+	}
+	apiKey := &domain.APIKey{
+		UserID:    userID,
+		StoreID:   storeID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   auth.HashAPIKey(key),
+		Methods:   methods,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.CreateAPIKey(ctx, apiKey); err != nil {
+		return "", nil, err
+	}
+	return key, apiKey, nil
+}
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	return s.repo.ListAPIKeys(ctx, userID)
+}
+
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, id, userID int64) error {
+	return s.repo.RevokeAPIKey(ctx, id, userID)
+}
+
+// Authenticate resolves an API key presented for the RPC named method and
+// returns the key together with its owner.
+func (s *APIKeyService) Authenticate(ctx context.Context, key, method string) (*domain.APIKey, *domain.User, error) {
+	prefix, ok := auth.ParseAPIKey(key)
+	if !ok {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+	apiKey, err := s.repo.FindAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	if apiKey == nil || apiKey.RevokedAt != nil ||
+		subtle.ConstantTimeCompare([]byte(apiKey.KeyHash), []byte(auth.HashAPIKey(key))) != 1 {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+	if !apiKey.AllowsMethod(method) {
+		return nil, nil, domain.ErrAPIKeyNotAllowed
+	}
+	user, err := s.repo.FindUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	now := time.Now().UTC()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedResolution {
+		if err := s.repo.TouchAPIKey(ctx, apiKey.ID, now); err != nil {
+			fmt.Printf("Failed to update api key last use: %v\n", err)
+		}
+	}
+	return apiKey, user, nil
+}
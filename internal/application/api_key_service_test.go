@@ -0,0 +1,151 @@
+// internal/application/api_key_service_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+func TestAPIKeyService_CreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewAPIKeyService(mockRepo)
+
+	mockRepo.EXPECT().FindStore(gomock.Any(), int64(5)).Return(&domain.Store{ID: 5, UserID: 1}, nil).Times(2)
+	var stored *domain.APIKey
+	mockRepo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *domain.APIKey) error {
+		stored = k
+		return nil
+	})
+
+	key, apiKey, err := svc.CreateAPIKey(context.Background(), 1, "shop backend", 5, []string{"CreateOrder"})
+	if err != nil {
+		t.Fatalf("CreateAPIKey() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(key, "gek_"+apiKey.Prefix+".") {
+		t.Errorf("CreateAPIKey() key = %v, want gek_%v.<secret>", key, apiKey.Prefix)
+	}
+	if stored.KeyHash != auth.HashAPIKey(key) || strings.Contains(stored.KeyHash, key) {
+		t.Errorf("CreateAPIKey() stored hash = %v, want hash of the key only", stored.KeyHash)
+	}
+	if stored.StoreID != 5 || stored.UserID != 1 {
+		t.Errorf("CreateAPIKey() stored = %+v, want user 1 and store 5", stored)
+	}
+
+	if _, _, err := svc.CreateAPIKey(context.Background(), 1, "no methods", 0, nil); err == nil {
+		t.Errorf("CreateAPIKey() without methods, want error")
+	}
+	if _, _, err := svc.CreateAPIKey(context.Background(), 2, "other store", 5, []string{"CreateOrder"}); !errors.Is(err, domain.ErrStoreNotFound) {
+		t.Errorf("CreateAPIKey() for another user's store error = %v, want %v", err, domain.ErrStoreNotFound)
+	}
+}
+
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewAPIKeyService(mockRepo)
+
+	key, prefix, _ := auth.GenerateAPIKey()
+	recent := time.Now().UTC()
+	revokedAt := time.Now().UTC()
+	stored := func() *domain.APIKey {
+		return &domain.APIKey{ID: 3, UserID: 1, Prefix: prefix, KeyHash: auth.HashAPIKey(key), Methods: []string{"CreateOrder"}}
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		method    string
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name:   "Valid key records last use",
+			key:    key,
+			method: "CreateOrder",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindAPIKeyByPrefix(gomock.Any(), prefix).Return(stored(), nil)
+				mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, Roles: []string{domain.RoleMerchant}}, nil)
+				mockRepo.EXPECT().TouchAPIKey(gomock.Any(), int64(3), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "Recently used key is not touched again",
+			key:    key,
+			method: "CreateOrder",
+			mockSetup: func() {
+				k := stored()
+				k.LastUsedAt = &recent
+				mockRepo.EXPECT().FindAPIKeyByPrefix(gomock.Any(), prefix).Return(k, nil)
+				mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, Roles: []string{domain.RoleMerchant}}, nil)
+			},
+		},
+		{
+			name:   "Wrong secret",
+			key:    "gek_" + prefix + ".guessed",
+			method: "CreateOrder",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindAPIKeyByPrefix(gomock.Any(), prefix).Return(stored(), nil)
+			},
+			wantErr: domain.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Revoked key",
+			key:    key,
+			method: "CreateOrder",
+			mockSetup: func() {
+				k := stored()
+				k.RevokedAt = &revokedAt
+				mockRepo.EXPECT().FindAPIKeyByPrefix(gomock.Any(), prefix).Return(k, nil)
+			},
+			wantErr: domain.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Method outside key scope",
+			key:    key,
+			method: "CancelOrder",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindAPIKeyByPrefix(gomock.Any(), prefix).Return(stored(), nil)
+			},
+			wantErr: domain.ErrAPIKeyNotAllowed,
+		},
+		{
+			name:      "Malformed key",
+			key:       "not-a-key",
+			method:    "CreateOrder",
+			mockSetup: func() {},
+			wantErr:   domain.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			apiKey, user, err := svc.Authenticate(context.Background(), tt.key, tt.method)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Authenticate() unexpected error: %v", err)
+			}
+			if apiKey == nil || user == nil || user.ID != 1 {
+				t.Errorf("Authenticate() key = %v, user = %v, want key of user 1", apiKey, user)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
@@ -12,17 +14,38 @@ import (
 )
 
 type AuthService struct {
-	repo ports.OrderRepositoryPort
+	repo        ports.OrderRepositoryPort
+	revocations ports.RevocationStorePort
+	notifier    ports.NotifierPort
+	throttle    *loginThrottle
 }
 
-func NewAuthService(repo ports.OrderRepositoryPort) *AuthService {
-	return &AuthService{repo: repo}
+// PasswordResetTTL is how long a password reset token stays valid.
+var PasswordResetTTL = 30 * time.Minute
+
+// NewAuthService creates the service. cache may be nil, which disables login
+// throttling.
+func NewAuthService(repo ports.OrderRepositoryPort, revocations ports.RevocationStorePort, cache ports.CachePort, notifier ports.NotifierPort, lockout LockoutConfig) *AuthService {
+	return &AuthService{
+		repo:        repo,
+		revocations: revocations,
+		notifier:    notifier,
+		throttle:    &loginThrottle{cache: cache, cfg: lockout},
+	}
 }
 
+// Signup creates an unverified account and sends it an email verification
+// code. Usernames are email addresses.
 func (s *AuthService) Signup(ctx context.Context, username, password string) (*domain.User, error) {
 	if username == "" || password == "" {
 		return nil, errors.New("username and password are required")
 	}
+	if err := validateEmail(username); err != nil {
+		return nil, err
+	}
+	if err := validatePassword(username, password); err != nil {
+		return nil, err
+	}
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, errors.New("failed to hash password")
@@ -31,32 +54,334 @@ func (s *AuthService) Signup(ctx context.Context, username, password string) (*d
 	if err != nil {
 		return nil, err
 	}
+	// The account exists either way; the user can ask for a new code
+	if err := s.sendVerificationCode(ctx, user); err != nil {
+		fmt.Printf("Failed to send verification code: %v\n", err)
+	}
 	return user, nil
 }
 
-func (s *AuthService) Login(ctx context.Context, username, password string) (string, *domain.User, error) {
+// Login checks the credentials and issues a token pair, or only a challenge
+// token if the user has two-factor authentication enabled (see
+// VerifyTwoFactor). Failed attempts are counted per username and per client
+// IP; while either is locked out Login returns a *domain.LockoutError without
+// checking the password.
+func (s *AuthService) Login(ctx context.Context, username, password string, client domain.ClientInfo) (*domain.TokenPair, *domain.User, error) {
+	if err := s.throttle.check(ctx, username, client.IP); err != nil {
+		return nil, nil, err
+	}
 	user, err := s.repo.FindUserByUsername(ctx, username)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 	if user == nil {
-		return "", nil, errors.New("invalid credentials")
+		s.throttle.failure(ctx, username, client.IP)
+		return nil, nil, errors.New("invalid credentials")
 	}
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
-		return "", nil, errors.New("invalid credentials")
+		s.throttle.failure(ctx, username, client.IP)
+		return nil, nil, errors.New("invalid credentials")
+	}
+	tf, err := s.repo.FindTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tf.Enabled() {
+		// Failures are only reset once the second factor is accepted too
+		challenge, err := auth.GenerateChallengeToken(user.Username, user.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &domain.TokenPair{ChallengeToken: challenge}, user, nil
+	}
+	s.throttle.success(ctx, username)
+	tokens, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokens, user, nil
+}
+
+// startSession records a new login session and issues its first token pair.
+func (s *AuthService) startSession(ctx context.Context, user *domain.User, client domain.ClientInfo) (*domain.TokenPair, error) {
+	// The refresh token family doubles as the session ID
+	familyID, err := auth.RandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.createSession(ctx, user.ID, familyID, client); err != nil {
+		return nil, err
+	}
+	return s.issueTokens(ctx, user, familyID)
+}
+
+// RefreshToken exchanges a refresh token for a new access/refresh token pair.
+// Every refresh token can be used exactly once; presenting one that was
+// already used revokes its whole family, logging out both the legitimate
+// client and whoever replayed the stolen token. The session of the token is
+// marked as seen from client.
+func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string, client domain.ClientInfo) (*domain.TokenPair, error) {
+	if refreshToken == "" {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	stored, err := s.repo.FindRefreshToken(ctx, auth.HashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil || stored.RevokedAt != nil {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if stored.UsedAt != nil {
+		return nil, s.revokeFamily(ctx, stored.FamilyID)
+	}
+	if time.Now().UTC().After(stored.ExpiresAt) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if err := s.repo.UseRefreshToken(ctx, stored.ID); err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			return nil, s.revokeFamily(ctx, stored.FamilyID)
+		}
+		return nil, err
+	}
+
+	user, err := s.repo.FindUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if err := s.repo.TouchSession(ctx, stored.FamilyID, client.IP, time.Now().UTC()); err != nil {
+		fmt.Printf("Failed to update session last seen: %v\n", err)
+	}
+	return s.issueTokens(ctx, user, stored.FamilyID)
+}
+
+// ChangePassword replaces the password of a logged-in user after checking the
+// current one. All of the user's tokens are revoked, including the one used
+// for this call.
+func (s *AuthService) ChangePassword(ctx context.Context, userID int64, currentPassword, newPassword string) error {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(currentPassword)); err != nil {
+		return errors.New("invalid credentials")
+	}
+	if currentPassword == newPassword {
+		return errors.New("new password must differ from the current one")
+	}
+	if err := validatePassword(user.Username, newPassword); err != nil {
+		return err
+	}
+	if err := s.setPassword(ctx, user.ID, newPassword); err != nil {
+		return err
+	}
+	return s.revokeAllTokens(ctx, user.ID)
+}
+
+// RequestPasswordReset sends a single-use reset token to the user. It reports
+// success for unknown usernames too, so it cannot be used to probe accounts.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, username string) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	user, err := s.repo.FindUserByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return nil
+	}
+	if err := s.repo.InvalidatePasswordResets(ctx, user.ID); err != nil {
+		return err
+	}
+	token, err := auth.RandomString(32)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	err = s.repo.CreatePasswordReset(ctx, &domain.PasswordReset{
+		UserID:    user.ID,
+		TokenHash: auth.HashPasswordResetToken(token),
+		ExpiresAt: now.Add(PasswordResetTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	return s.notifier.Notify(ctx, domain.Notification{
+		To:      user.Username,
+		Subject: "Password reset",
+		Body: fmt.Sprintf("Use this token to reset your password: %s\nIt expires in %s. If you did not ask for a reset you can ignore this message.",
+			token, PasswordResetTTL),
+	})
+}
+
+// ResetPassword sets a new password using a token from RequestPasswordReset
+// and revokes every token of the user.
+func (s *AuthService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if token == "" {
+		return domain.ErrInvalidResetToken
+	}
+	reset, err := s.repo.FindPasswordReset(ctx, auth.HashPasswordResetToken(token))
+	if err != nil {
+		return err
+	}
+	if reset == nil || reset.UsedAt != nil || time.Now().UTC().After(reset.ExpiresAt) {
+		return domain.ErrInvalidResetToken
+	}
+	user, err := s.repo.FindUserByID(ctx, reset.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domain.ErrInvalidResetToken
+	}
+	if err := validatePassword(user.Username, newPassword); err != nil {
+		return err
+	}
+	if err := s.repo.UsePasswordReset(ctx, reset.ID); err != nil {
+		return err
+	}
+	if err := s.setPassword(ctx, user.ID, newPassword); err != nil {
+		return err
+	}
+	return s.revokeAllTokens(ctx, user.ID)
+}
+
+func (s *AuthService) setPassword(ctx context.Context, userID int64, password string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.New("failed to hash password")
+	}
+	return s.repo.UpdateUserPassword(ctx, userID, string(hashedPassword))
+}
+
+// revokeAllTokens invalidates every access token issued to the user so far
+// and all of their refresh tokens.
+func (s *AuthService) revokeAllTokens(ctx context.Context, userID int64) error {
+	now := time.Now().UTC()
+	if err := s.revocations.RevokeUser(ctx, userID, now, now.Add(auth.AccessTokenTTL)); err != nil {
+		return err
+	}
+	return s.repo.RevokeUserRefreshTokens(ctx, userID)
+}
+
+// UnlockAccount clears the failed login counter and lockout of a username.
+func (s *AuthService) UnlockAccount(ctx context.Context, username string) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	return s.throttle.unlock(ctx, username)
+}
+
+// UpdateUserRoles replaces the roles of a user. Tokens already issued keep
+// their old roles until they expire or are refreshed.
+func (s *AuthService) UpdateUserRoles(ctx context.Context, userID int64, roles []string) error {
+	if len(roles) == 0 {
+		return errors.New("at least one role is required")
+	}
+	seen := make(map[string]bool)
+	var unique []string
+	for _, role := range roles {
+		if !domain.ValidRole(role) {
+			return fmt.Errorf("unknown role %q", role)
+		}
+		if !seen[role] {
+			seen[role] = true
+			unique = append(unique, role)
+		}
+	}
+	return s.repo.UpdateUserRoles(ctx, userID, unique)
+}
+
+func (s *AuthService) issueTokens(ctx context.Context, user *domain.User, familyID string) (*domain.TokenPair, error) {
+	accessToken, err := auth.GenerateToken(user.Username, user.ID, user.Roles, familyID)
+	if err != nil {
+		return nil, err
 	}
-	token, err := auth.GenerateToken(username, user.ID)
+	refreshToken, err := auth.GenerateRefreshToken()
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
-	return token, user, nil
+	now := time.Now().UTC()
+	err = s.repo.CreateRefreshToken(ctx, &domain.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: auth.HashRefreshToken(refreshToken),
+		ExpiresAt: now.Add(auth.RefreshTokenTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(auth.AccessTokenTTL.Seconds()),
+	}, nil
 }
 
+func (s *AuthService) revokeFamily(ctx context.Context, familyID string) error {
+	if err := s.repo.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return err
+	}
+	return domain.ErrRefreshTokenReused
+}
+
+// Authenticate validates an access token and checks that it has not been
+// revoked. It is called for every authenticated RPC.
+func (s *AuthService) Authenticate(ctx context.Context, token string) (*auth.Claims, error) {
+	claims, err := auth.ValidateToken(token)
+	if err != nil || claims.ID == "" {
+		return nil, domain.ErrInvalidToken
+	}
+	revoked, err := s.revocations.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, domain.ErrTokenRevoked
+	}
+	if claims.SessionID != "" {
+		revoked, err = s.revocations.IsRevoked(ctx, sessionRevocationID(claims.SessionID))
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, domain.ErrTokenRevoked
+		}
+	}
+	revokedBefore, err := s.revocations.UserRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !revokedBefore.IsZero() && (claims.IssuedAt == nil || !claims.IssuedAt.Time.After(revokedBefore)) {
+		return nil, domain.ErrTokenRevoked
+	}
+	return claims, nil
+}
+
+// Logout revokes the access token of the current call until it expires, and
+// ends its session if it has one.
 func (s *AuthService) Logout(ctx context.Context, userID int64) error {
 	token, ok := ctx.Value("token").(string)
 	if !ok {
 		return errors.New("token not found in context")
 	}
-	auth.BlacklistToken(token)
-	return nil
+	claims, err := auth.ValidateToken(token)
+	if err != nil || claims.UserID != userID || claims.ID == "" {
+		return domain.ErrInvalidToken
+	}
+	if err := s.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return err
+	}
+	if claims.SessionID == "" {
+		return nil
+	}
+	return s.RevokeSession(ctx, userID, claims.SessionID)
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
@@ -19,7 +20,9 @@ func TestAuthService_Signup(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
-	svc := NewAuthService(mockRepo)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	mockNotifier := ports.NewMockNotifierPort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, mockNotifier, DefaultLockoutConfig())
 
 	tests := []struct {
 		name      string
@@ -32,25 +35,44 @@ func TestAuthService_Signup(t *testing.T) {
 		{
 			name:     "Successful signup",
 			username: "testuser@example.com",
-			password: "securepass",
+			password: "securepass1",
 			mockSetup: func() {
-				hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass"), bcrypt.DefaultCost)
+				hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass1"), bcrypt.DefaultCost)
 				mockRepo.EXPECT().CreateUser(gomock.Any(), "testuser@example.com", gomock.Any()).Return(&domain.User{ID: 1, Username: "testuser@example.com", Password: string(hashed)}, nil)
+				mockRepo.EXPECT().InvalidateEmailVerifications(gomock.Any(), int64(1)).Return(nil)
+				mockRepo.EXPECT().CreateEmailVerification(gomock.Any(), gomock.Any()).Return(nil)
+				mockNotifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name:      "Missing username",
 			username:  "",
-			password:  "securepass",
+			password:  "securepass1",
 			mockSetup: func() {},
 			wantErr:   true,
 			errMsg:    "username and password are required",
 		},
+		{
+			name:      "Invalid email",
+			username:  "testuser",
+			password:  "securepass1",
+			mockSetup: func() {},
+			wantErr:   true,
+			errMsg:    "username must be a valid email address",
+		},
+		{
+			name:      "Weak password",
+			username:  "testuser@example.com",
+			password:  "password",
+			mockSetup: func() {},
+			wantErr:   true,
+			errMsg:    "password must contain at least one letter and one digit",
+		},
 		{
 			name:     "Repository error",
 			username: "testuser@example.com",
-			password: "securepass",
+			password: "securepass1",
 			mockSetup: func() {
 				mockRepo.EXPECT().CreateUser(gomock.Any(), "testuser@example.com", gomock.Any()).Return(nil, errors.New("username already exists"))
 			},
@@ -84,9 +106,11 @@ func TestAuthService_Login(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
-	svc := NewAuthService(mockRepo)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
 
-	hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass"), bcrypt.DefaultCost)
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass1"), bcrypt.DefaultCost)
+	var sessionID string
 
 	tests := []struct {
 		name      string
@@ -99,9 +123,23 @@ func TestAuthService_Login(t *testing.T) {
 		{
 			name:     "Successful login",
 			username: "testuser@example.com",
-			password: "securepass",
+			password: "securepass1",
 			mockSetup: func() {
 				mockRepo.EXPECT().FindUserByUsername(gomock.Any(), "testuser@example.com").Return(&domain.User{ID: 1, Username: "testuser@example.com", Password: string(hashed)}, nil)
+				mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(nil, nil)
+				mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sess *domain.Session) error {
+					sessionID = sess.ID
+					if sess.UserID != 1 || sess.IP != "10.0.0.1" || sess.Device != "Pixel 8" {
+						t.Errorf("CreateSession() session = %v, want user 1 on Pixel 8 from 10.0.0.1", sess)
+					}
+					return nil
+				})
+				mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rt *domain.RefreshToken) error {
+					if rt.FamilyID != sessionID {
+						t.Errorf("CreateRefreshToken() family = %v, want session %v", rt.FamilyID, sessionID)
+					}
+					return nil
+				})
 			},
 			wantErr: false,
 		},
@@ -118,7 +156,7 @@ func TestAuthService_Login(t *testing.T) {
 		{
 			name:     "User not found",
 			username: "testuser@example.com",
-			password: "securepass",
+			password: "securepass1",
 			mockSetup: func() {
 				mockRepo.EXPECT().FindUserByUsername(gomock.Any(), "testuser@example.com").Return(nil, nil)
 			},
@@ -130,7 +168,7 @@ func TestAuthService_Login(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
-			token, user, err := svc.Login(context.Background(), tt.username, tt.password)
+			tokens, user, err := svc.Login(context.Background(), tt.username, tt.password, domain.ClientInfo{IP: "10.0.0.1", Device: "Pixel 8"})
 			if tt.wantErr {
 				if err == nil || err.Error() != tt.errMsg {
 					t.Errorf("Login() error = %v, wantErr %v, errMsg %v", err, tt.wantErr, tt.errMsg)
@@ -140,8 +178,160 @@ func TestAuthService_Login(t *testing.T) {
 			if err != nil {
 				t.Errorf("Login() unexpected error: %v", err)
 			}
-			if user == nil || user.Username != tt.username || token == "" {
-				t.Errorf("Login() user = %v, token = %v, want username %v, non-empty token", user, token, tt.username)
+			if user == nil || user.Username != tt.username || tokens == nil || tokens.AccessToken == "" || tokens.RefreshToken == "" {
+				t.Errorf("Login() user = %v, tokens = %v, want username %v, non-empty tokens", user, tokens, tt.username)
+			}
+			if tokens.ExpiresIn != int64(auth.AccessTokenTTL.Seconds()) {
+				t.Errorf("Login() expiresIn = %v, want %v", tokens.ExpiresIn, int64(auth.AccessTokenTTL.Seconds()))
+			}
+			if claims, err := auth.ValidateToken(tokens.AccessToken); err != nil || claims.SessionID != sessionID {
+				t.Errorf("Login() access token session = %v, want %v", claims, sessionID)
+			}
+		})
+	}
+}
+
+func TestAuthService_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
+
+	refresh, _ := auth.GenerateRefreshToken()
+	hash := auth.HashRefreshToken(refresh)
+	usedAt := time.Now().UTC().Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		token     string
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name:  "Successful rotation",
+			token: refresh,
+			mockSetup: func() {
+				mockRepo.EXPECT().FindRefreshToken(gomock.Any(), hash).Return(&domain.RefreshToken{ID: 7, UserID: 1, FamilyID: "fam", TokenHash: hash, ExpiresAt: time.Now().UTC().Add(time.Hour)}, nil)
+				mockRepo.EXPECT().UseRefreshToken(gomock.Any(), int64(7)).Return(nil)
+				mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, Username: "testuser@example.com"}, nil)
+				mockRepo.EXPECT().TouchSession(gomock.Any(), "fam", "10.0.0.2", gomock.Any()).Return(nil)
+				mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rt *domain.RefreshToken) error {
+					if rt.FamilyID != "fam" || rt.TokenHash == hash {
+						t.Errorf("CreateRefreshToken() token = %v, want new hash in family fam", rt)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:  "Reused token revokes family",
+			token: refresh,
+			mockSetup: func() {
+				mockRepo.EXPECT().FindRefreshToken(gomock.Any(), hash).Return(&domain.RefreshToken{ID: 7, UserID: 1, FamilyID: "fam", TokenHash: hash, ExpiresAt: time.Now().UTC().Add(time.Hour), UsedAt: &usedAt}, nil)
+				mockRepo.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), "fam").Return(nil)
+			},
+			wantErr: domain.ErrRefreshTokenReused,
+		},
+		{
+			name:  "Concurrent use revokes family",
+			token: refresh,
+			mockSetup: func() {
+				mockRepo.EXPECT().FindRefreshToken(gomock.Any(), hash).Return(&domain.RefreshToken{ID: 7, UserID: 1, FamilyID: "fam", TokenHash: hash, ExpiresAt: time.Now().UTC().Add(time.Hour)}, nil)
+				mockRepo.EXPECT().UseRefreshToken(gomock.Any(), int64(7)).Return(domain.ErrRefreshTokenReused)
+				mockRepo.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), "fam").Return(nil)
+			},
+			wantErr: domain.ErrRefreshTokenReused,
+		},
+		{
+			name:  "Expired token",
+			token: refresh,
+			mockSetup: func() {
+				mockRepo.EXPECT().FindRefreshToken(gomock.Any(), hash).Return(&domain.RefreshToken{ID: 7, UserID: 1, FamilyID: "fam", TokenHash: hash, ExpiresAt: time.Now().UTC().Add(-time.Hour)}, nil)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name:  "Unknown token",
+			token: refresh,
+			mockSetup: func() {
+				mockRepo.EXPECT().FindRefreshToken(gomock.Any(), hash).Return(nil, nil)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			tokens, err := svc.RefreshToken(context.Background(), tt.token, domain.ClientInfo{IP: "10.0.0.2"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("RefreshToken() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("RefreshToken() unexpected error: %v", err)
+			}
+			if tokens == nil || tokens.AccessToken == "" || tokens.RefreshToken == "" || tokens.RefreshToken == tt.token {
+				t.Errorf("RefreshToken() tokens = %v, want a new token pair", tokens)
+			}
+		})
+	}
+}
+
+func TestAuthService_UpdateUserRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
+
+	tests := []struct {
+		name      string
+		roles     []string
+		mockSetup func()
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:  "Duplicates are removed",
+			roles: []string{"merchant", "admin", "merchant"},
+			mockSetup: func() {
+				mockRepo.EXPECT().UpdateUserRoles(gomock.Any(), int64(1), []string{"merchant", "admin"}).Return(nil)
+			},
+		},
+		{
+			name:      "Unknown role",
+			roles:     []string{"superuser"},
+			mockSetup: func() {},
+			wantErr:   true,
+			errMsg:    `unknown role "superuser"`,
+		},
+		{
+			name:      "No roles",
+			roles:     nil,
+			mockSetup: func() {},
+			wantErr:   true,
+			errMsg:    "at least one role is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			err := svc.UpdateUserRoles(context.Background(), 1, tt.roles)
+			if tt.wantErr {
+				if err == nil || err.Error() != tt.errMsg {
+					t.Errorf("UpdateUserRoles() error = %v, wantErr %v, errMsg %v", err, tt.wantErr, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("UpdateUserRoles() unexpected error: %v", err)
 			}
 		})
 	}
@@ -152,34 +342,50 @@ func TestAuthService_Logout(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
-	svc := NewAuthService(mockRepo)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
 
-	token, _ := auth.GenerateToken("testuser@example.com", 1)
+	token, _ := auth.GenerateToken("testuser@example.com", 1, []string{"merchant"}, "")
+	claims, _ := auth.ValidateToken(token)
 
 	tests := []struct {
-		name    string
-		ctx     context.Context
-		userID  int64
-		wantErr bool
-		errMsg  string
+		name      string
+		ctx       context.Context
+		userID    int64
+		mockSetup func()
+		wantErr   bool
+		errMsg    string
 	}{
 		{
-			name:    "Successful logout",
-			ctx:     context.WithValue(context.Background(), "token", token),
-			userID:  1,
+			name:   "Successful logout",
+			ctx:    context.WithValue(context.Background(), "token", token),
+			userID: 1,
+			mockSetup: func() {
+				mockRevocations.EXPECT().Revoke(gomock.Any(), claims.ID, claims.ExpiresAt.Time).Return(nil)
+			},
 			wantErr: false,
 		},
 		{
-			name:    "Missing token",
-			ctx:     context.Background(),
-			userID:  1,
-			wantErr: true,
-			errMsg:  "token not found in context",
+			name:      "Missing token",
+			ctx:       context.Background(),
+			userID:    1,
+			mockSetup: func() {},
+			wantErr:   true,
+			errMsg:    "token not found in context",
+		},
+		{
+			name:      "Token of another user",
+			ctx:       context.WithValue(context.Background(), "token", token),
+			userID:    2,
+			mockSetup: func() {},
+			wantErr:   true,
+			errMsg:    "invalid token",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
 			err := svc.Logout(tt.ctx, tt.userID)
 			if tt.wantErr {
 				if err == nil || err.Error() != tt.errMsg {
@@ -190,10 +396,271 @@ func TestAuthService_Logout(t *testing.T) {
 			if err != nil {
 				t.Errorf("Logout() unexpected error: %v", err)
 			}
-			_, err = auth.ValidateToken(token)
-			if err == nil || !strings.Contains(err.Error(), "token is blacklisted") {
-				t.Errorf("Logout() token not blacklisted, err = %v", err)
+		})
+	}
+}
+
+func TestAuthService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
+
+	token, _ := auth.GenerateToken("testuser@example.com", 1, []string{"merchant"}, "")
+	claims, _ := auth.ValidateToken(token)
+
+	tests := []struct {
+		name      string
+		token     string
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name:  "Valid token",
+			token: token,
+			mockSetup: func() {
+				mockRevocations.EXPECT().IsRevoked(gomock.Any(), claims.ID).Return(false, nil)
+				mockRevocations.EXPECT().UserRevokedBefore(gomock.Any(), int64(1)).Return(time.Time{}, nil)
+			},
+		},
+		{
+			name:  "Token issued before the user's tokens were revoked",
+			token: token,
+			mockSetup: func() {
+				mockRevocations.EXPECT().IsRevoked(gomock.Any(), claims.ID).Return(false, nil)
+				mockRevocations.EXPECT().UserRevokedBefore(gomock.Any(), int64(1)).Return(claims.IssuedAt.Time.Add(time.Second), nil)
+			},
+			wantErr: domain.ErrTokenRevoked,
+		},
+		{
+			name:  "Token issued a moment before the revocation",
+			token: token,
+			mockSetup: func() {
+				mockRevocations.EXPECT().IsRevoked(gomock.Any(), claims.ID).Return(false, nil)
+				mockRevocations.EXPECT().UserRevokedBefore(gomock.Any(), int64(1)).Return(claims.IssuedAt.Time.Add(time.Millisecond), nil)
+			},
+			wantErr: domain.ErrTokenRevoked,
+		},
+		{
+			name:  "Token issued a moment after the revocation",
+			token: token,
+			mockSetup: func() {
+				mockRevocations.EXPECT().IsRevoked(gomock.Any(), claims.ID).Return(false, nil)
+				mockRevocations.EXPECT().UserRevokedBefore(gomock.Any(), int64(1)).Return(claims.IssuedAt.Time.Add(-time.Millisecond), nil)
+			},
+		},
+		{
+			name:  "Revoked token",
+			token: token,
+			mockSetup: func() {
+				mockRevocations.EXPECT().IsRevoked(gomock.Any(), claims.ID).Return(true, nil)
+			},
+			wantErr: domain.ErrTokenRevoked,
+		},
+		{
+			name:      "Malformed token",
+			token:     "not-a-jwt",
+			mockSetup: func() {},
+			wantErr:   domain.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			got, err := svc.Authenticate(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Authenticate() unexpected error: %v", err)
+			}
+			if got == nil || got.UserID != 1 {
+				t.Errorf("Authenticate() claims = %v, want user 1", got)
 			}
 		})
 	}
 }
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
+
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass1"), bcrypt.MinCost)
+	user := &domain.User{ID: 1, Username: "testuser@example.com", Password: string(hashed)}
+
+	tests := []struct {
+		name        string
+		current     string
+		newPassword string
+		mockSetup   func()
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name:        "Successful change",
+			current:     "securepass1",
+			newPassword: "newsecret22",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(user, nil)
+				mockRepo.EXPECT().UpdateUserPassword(gomock.Any(), int64(1), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int64, hashed string) error {
+						if bcrypt.CompareHashAndPassword([]byte(hashed), []byte("newsecret22")) != nil {
+							t.Errorf("UpdateUserPassword() got a hash of the wrong password")
+						}
+						return nil
+					})
+				mockRevocations.EXPECT().RevokeUser(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), int64(1)).Return(nil)
+			},
+		},
+		{
+			name:        "Wrong current password",
+			current:     "wrongpass1",
+			newPassword: "newsecret22",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(user, nil)
+			},
+			wantErr: true,
+			errMsg:  "invalid credentials",
+		},
+		{
+			name:        "Weak new password",
+			current:     "securepass1",
+			newPassword: "short1",
+			mockSetup: func() {
+				mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(user, nil)
+			},
+			wantErr: true,
+			errMsg:  "password must be at least 8 characters long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			err := svc.ChangePassword(context.Background(), 1, tt.current, tt.newPassword)
+			if tt.wantErr {
+				if err == nil || err.Error() != tt.errMsg {
+					t.Errorf("ChangePassword() error = %v, wantErr %v, errMsg %v", err, tt.wantErr, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ChangePassword() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthService_PasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	mockNotifier := ports.NewMockNotifierPort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, mockNotifier, DefaultLockoutConfig())
+
+	ctx := context.Background()
+	user := &domain.User{ID: 1, Username: "testuser@example.com"}
+
+	// Unknown usernames succeed without sending anything
+	mockRepo.EXPECT().FindUserByUsername(gomock.Any(), "nobody@example.com").Return(nil, nil)
+	if err := svc.RequestPasswordReset(ctx, "nobody@example.com"); err != nil {
+		t.Fatalf("RequestPasswordReset() unknown user error: %v", err)
+	}
+
+	var stored *domain.PasswordReset
+	var sent domain.Notification
+	mockRepo.EXPECT().FindUserByUsername(gomock.Any(), user.Username).Return(user, nil)
+	mockRepo.EXPECT().InvalidatePasswordResets(gomock.Any(), user.ID).Return(nil)
+	mockRepo.EXPECT().CreatePasswordReset(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, reset *domain.PasswordReset) error {
+			reset.ID = 7
+			stored = reset
+			return nil
+		})
+	mockNotifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n domain.Notification) error {
+			sent = n
+			return nil
+		})
+	if err := svc.RequestPasswordReset(ctx, user.Username); err != nil {
+		t.Fatalf("RequestPasswordReset() error: %v", err)
+	}
+	if sent.To != user.Username {
+		t.Fatalf("RequestPasswordReset() notified %q, want %q", sent.To, user.Username)
+	}
+
+	// Recover the token from the message the same way a user would
+	var token string
+	for _, field := range strings.Fields(sent.Body) {
+		if auth.HashPasswordResetToken(field) == stored.TokenHash {
+			token = field
+		}
+	}
+	if token == "" {
+		t.Fatalf("reset token not found in notification %q", sent.Body)
+	}
+
+	mockRepo.EXPECT().FindPasswordReset(gomock.Any(), stored.TokenHash).Return(stored, nil)
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), user.ID).Return(user, nil)
+	mockRepo.EXPECT().UsePasswordReset(gomock.Any(), int64(7)).Return(nil)
+	var revokedBefore time.Time
+	mockRepo.EXPECT().UpdateUserPassword(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, hash string) error {
+			user.Password = hash
+			return nil
+		})
+	mockRevocations.EXPECT().RevokeUser(gomock.Any(), user.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, issuedBefore, _ time.Time) error {
+			revokedBefore = issuedBefore
+			return nil
+		})
+	mockRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), user.ID).Return(nil)
+	if err := svc.ResetPassword(ctx, token, "newsecret22"); err != nil {
+		t.Fatalf("ResetPassword() error: %v", err)
+	}
+
+	// Logging in right away, within the second of the reset, gives a valid token
+	mockRepo.EXPECT().FindUserByUsername(gomock.Any(), user.Username).Return(user, nil)
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), user.ID).Return(nil, nil)
+	mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+	tokens, _, err := svc.Login(ctx, user.Username, "newsecret22", domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("Login() after reset error: %v", err)
+	}
+	mockRevocations.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).Return(false, nil).Times(2)
+	mockRevocations.EXPECT().UserRevokedBefore(gomock.Any(), user.ID).Return(revokedBefore, nil)
+	if _, err := svc.Authenticate(ctx, tokens.AccessToken); err != nil {
+		t.Fatalf("Authenticate() token from login after reset error: %v", err)
+	}
+
+	// A token can only be used once
+	usedAt := time.Now().UTC()
+	used := *stored
+	used.UsedAt = &usedAt
+	mockRepo.EXPECT().FindPasswordReset(gomock.Any(), stored.TokenHash).Return(&used, nil)
+	if err := svc.ResetPassword(ctx, token, "newsecret33"); !errors.Is(err, domain.ErrInvalidResetToken) {
+		t.Fatalf("ResetPassword() reused token error = %v, want %v", err, domain.ErrInvalidResetToken)
+	}
+
+	// Expired tokens are refused
+	expired := *stored
+	expired.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	mockRepo.EXPECT().FindPasswordReset(gomock.Any(), stored.TokenHash).Return(&expired, nil)
+	if err := svc.ResetPassword(ctx, token, "newsecret33"); !errors.Is(err, domain.ErrInvalidResetToken) {
+		t.Fatalf("ResetPassword() expired token error = %v, want %v", err, domain.ErrInvalidResetToken)
+	}
+}
@@ -0,0 +1,137 @@
+// internal/application/email_verification.go
+package application
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+const (
+	verificationCodeDigits = 6
+	// maxVerificationAttempts bounds guesses per code; together with
+	// verificationResendInterval it keeps a 6 digit code out of brute force reach.
+	maxVerificationAttempts    = 5
+	verificationResendInterval = time.Minute
+)
+
+// EmailVerificationTTL is how long an email verification code stays valid.
+var EmailVerificationTTL = 24 * time.Hour
+
+// validateEmail checks that a username is a bare email address such as
+// "user@example.com", without a display name or angle brackets.
+func validateEmail(username string) error {
+	if len(username) > 254 {
+		return domain.ErrInvalidEmail
+	}
+	addr, err := mail.ParseAddress(username)
+	if err != nil || addr.Name != "" || addr.Address != username {
+		return domain.ErrInvalidEmail
+	}
+	return nil
+}
+
+// SendVerificationCode sends a new verification code to a user whose email is
+// not verified yet, replacing any earlier code.
+func (s *AuthService) SendVerificationCode(ctx context.Context, userID int64) error {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+	if user.EmailVerified {
+		return errors.New("email address already verified")
+	}
+	last, err := s.repo.FindEmailVerification(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if last != nil && time.Now().UTC().Sub(last.CreatedAt) < verificationResendInterval {
+		return errors.New("a verification code was sent recently, please wait before requesting another")
+	}
+	return s.sendVerificationCode(ctx, user)
+}
+
+func (s *AuthService) sendVerificationCode(ctx context.Context, user *domain.User) error {
+	if err := s.repo.InvalidateEmailVerifications(ctx, user.ID); err != nil {
+		return err
+	}
+	code, err := auth.GenerateVerificationCode(verificationCodeDigits)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	err = s.repo.CreateEmailVerification(ctx, &domain.EmailVerification{
+		UserID:    user.ID,
+		CodeHash:  auth.HashVerificationCode(code),
+		ExpiresAt: now.Add(EmailVerificationTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	return s.notifier.Notify(ctx, domain.Notification{
+		To:      user.Username,
+		Subject: "Verify your email address",
+		Body:    fmt.Sprintf("Your verification code is %s\nIt expires in %s.", code, EmailVerificationTTL),
+	})
+}
+
+// VerifyEmail activates the account of userID if code matches the last code
+// sent to them. Each code accepts a limited number of guesses.
+func (s *AuthService) VerifyEmail(ctx context.Context, userID int64, code string) error {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+	if user.EmailVerified {
+		return nil
+	}
+	v, err := s.repo.FindEmailVerification(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if v == nil || time.Now().UTC().After(v.ExpiresAt) {
+		return domain.ErrInvalidVerification
+	}
+	// Count the attempt before comparing so concurrent guesses cannot exceed the limit
+	attempts, err := s.repo.RecordEmailVerificationAttempt(ctx, v.ID)
+	if err != nil {
+		return err
+	}
+	if attempts > maxVerificationAttempts ||
+		subtle.ConstantTimeCompare([]byte(v.CodeHash), []byte(auth.HashVerificationCode(code))) != 1 {
+		return domain.ErrInvalidVerification
+	}
+	if err := s.repo.UseEmailVerification(ctx, v.ID); err != nil {
+		return err
+	}
+	return s.repo.MarkEmailVerified(ctx, userID)
+}
+
+// RequireVerifiedEmail returns domain.ErrEmailNotVerified unless the user has
+// verified their email address.
+func (s *AuthService) RequireVerifiedEmail(ctx context.Context, userID int64) error {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domain.ErrInvalidToken
+	}
+	if !user.EmailVerified {
+		return domain.ErrEmailNotVerified
+	}
+	return nil
+}
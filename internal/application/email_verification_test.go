@@ -0,0 +1,106 @@
+// internal/application/email_verification_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+func TestValidateEmail(t *testing.T) {
+	valid := []string{"user@example.com", "01901901901@mailinator.com", "first.last+tag@sub.example.org"}
+	invalid := []string{"", "user", "user@", "@example.com", "User <user@example.com>", "<user@example.com>", "a b@example.com"}
+	for _, email := range valid {
+		if err := validateEmail(email); err != nil {
+			t.Errorf("validateEmail(%q) error = %v, want nil", email, err)
+		}
+	}
+	for _, email := range invalid {
+		if err := validateEmail(email); !errors.Is(err, domain.ErrInvalidEmail) {
+			t.Errorf("validateEmail(%q) error = %v, want %v", email, err, domain.ErrInvalidEmail)
+		}
+	}
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockNotifier := ports.NewMockNotifierPort(ctrl)
+	svc := NewAuthService(mockRepo, ports.NewMockRevocationStorePort(ctrl), nil, mockNotifier, DefaultLockoutConfig())
+
+	ctx := context.Background()
+	user := &domain.User{ID: 1, Username: "testuser@example.com"}
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(user, nil).AnyTimes()
+
+	// Send a code and read it back from the notification
+	var stored *domain.EmailVerification
+	var code string
+	mockRepo.EXPECT().FindEmailVerification(gomock.Any(), int64(1)).Return(nil, nil)
+	mockRepo.EXPECT().InvalidateEmailVerifications(gomock.Any(), int64(1)).Return(nil)
+	mockRepo.EXPECT().CreateEmailVerification(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, v *domain.EmailVerification) error {
+			v.ID = 3
+			stored = v
+			return nil
+		})
+	mockNotifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n domain.Notification) error {
+			for _, field := range strings.Fields(n.Body) {
+				if auth.HashVerificationCode(field) == stored.CodeHash {
+					code = field
+				}
+			}
+			return nil
+		})
+	if err := svc.SendVerificationCode(ctx, 1); err != nil {
+		t.Fatalf("SendVerificationCode() error: %v", err)
+	}
+	if len(code) != 6 {
+		t.Fatalf("verification code %q not found in notification", code)
+	}
+
+	// Resending right away is refused
+	mockRepo.EXPECT().FindEmailVerification(gomock.Any(), int64(1)).Return(stored, nil)
+	if err := svc.SendVerificationCode(ctx, 1); err == nil {
+		t.Fatalf("SendVerificationCode() immediate resend error = nil, want error")
+	}
+
+	// A wrong code counts as an attempt
+	mockRepo.EXPECT().FindEmailVerification(gomock.Any(), int64(1)).Return(stored, nil)
+	mockRepo.EXPECT().RecordEmailVerificationAttempt(gomock.Any(), int64(3)).Return(int64(1), nil)
+	if err := svc.VerifyEmail(ctx, 1, "not-the-code"); !errors.Is(err, domain.ErrInvalidVerification) {
+		t.Fatalf("VerifyEmail() wrong code error = %v, want %v", err, domain.ErrInvalidVerification)
+	}
+
+	// The right code is refused once the attempts are used up
+	mockRepo.EXPECT().FindEmailVerification(gomock.Any(), int64(1)).Return(stored, nil)
+	mockRepo.EXPECT().RecordEmailVerificationAttempt(gomock.Any(), int64(3)).Return(int64(maxVerificationAttempts+1), nil)
+	if err := svc.VerifyEmail(ctx, 1, code); !errors.Is(err, domain.ErrInvalidVerification) {
+		t.Fatalf("VerifyEmail() after too many attempts error = %v, want %v", err, domain.ErrInvalidVerification)
+	}
+
+	// Expired codes are refused without counting an attempt
+	expired := *stored
+	expired.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	mockRepo.EXPECT().FindEmailVerification(gomock.Any(), int64(1)).Return(&expired, nil)
+	if err := svc.VerifyEmail(ctx, 1, code); !errors.Is(err, domain.ErrInvalidVerification) {
+		t.Fatalf("VerifyEmail() expired code error = %v, want %v", err, domain.ErrInvalidVerification)
+	}
+
+	mockRepo.EXPECT().FindEmailVerification(gomock.Any(), int64(1)).Return(stored, nil)
+	mockRepo.EXPECT().RecordEmailVerificationAttempt(gomock.Any(), int64(3)).Return(int64(2), nil)
+	mockRepo.EXPECT().UseEmailVerification(gomock.Any(), int64(3)).Return(nil)
+	mockRepo.EXPECT().MarkEmailVerified(gomock.Any(), int64(1)).Return(nil)
+	if err := svc.VerifyEmail(ctx, 1, code); err != nil {
+		t.Fatalf("VerifyEmail() error: %v", err)
+	}
+}
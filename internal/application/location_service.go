@@ -0,0 +1,135 @@
+// internal/application/location_service.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+// locationRefresh is how long the loaded location catalog is used before it
+// is loaded again, so a catalog seeded by another replica applies within that
+// time.
+const locationRefresh = 5 * time.Minute
+
+type LocationService struct {
+	repo ports.OrderRepositoryPort
+
+	mu       sync.Mutex
+	catalog  *domain.LocationCatalog
+	loadedAt time.Time
+}
+
+func NewLocationService(repo ports.OrderRepositoryPort) *LocationService {
+	return &LocationService{repo: repo}
+}
+
+// locationSeed is the format of a seed file: cities with their zones, and
+// zones with their areas. Areas are serviceable unless they say otherwise.
+type locationSeed struct {
+	Cities []struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name"`
+		Zones []struct {
+			ID    int64  `json:"id"`
+			Name  string `json:"name"`
+			Areas []struct {
+				ID          int64  `json:"id"`
+				Name        string `json:"name"`
+				Serviceable *bool  `json:"serviceable"`
+			} `json:"areas"`
+		} `json:"zones"`
+	} `json:"cities"`
+}
+
+// Seed reads a catalog from a JSON seed file and stores it. Locations already
+// stored are updated, and locations missing from the file are kept.
+func (s *LocationService) Seed(ctx context.Context, r io.Reader) error {
+	var seed locationSeed
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&seed); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidLocationCatalog, err)
+	}
+	catalog := &domain.LocationCatalog{}
+	for _, c := range seed.Cities {
+		catalog.Cities = append(catalog.Cities, domain.City{ID: c.ID, Name: c.Name})
+		for _, z := range c.Zones {
+			catalog.Zones = append(catalog.Zones, domain.Zone{ID: z.ID, CityID: c.ID, Name: z.Name})
+			for _, a := range z.Areas {
+				serviceable := a.Serviceable == nil || *a.Serviceable
+				catalog.Areas = append(catalog.Areas, domain.Area{ID: a.ID, ZoneID: z.ID, Name: a.Name, Serviceable: serviceable})
+			}
+		}
+	}
+	if err := catalog.Validate(); err != nil {
+		return err
+	}
+	if err := s.repo.SaveLocations(ctx, catalog); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.catalog = nil
+	s.mu.Unlock()
+	return nil
+}
+
+// ListCities returns every city, sorted by name.
+func (s *LocationService) ListCities(ctx context.Context) ([]domain.City, error) {
+	catalog, err := s.locations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.Cities, nil
+}
+
+// ListZones returns the zones of a city, sorted by name.
+func (s *LocationService) ListZones(ctx context.Context, cityID int64) ([]domain.Zone, error) {
+	catalog, err := s.locations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.ZonesOf(cityID), nil
+}
+
+// ListAreas returns the areas of a zone, sorted by name, including the ones
+// that are not serviceable.
+func (s *LocationService) ListAreas(ctx context.Context, zoneID int64) ([]domain.Area, error) {
+	catalog, err := s.locations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.AreasOf(zoneID), nil
+}
+
+// CheckDestination returns an error unless an order goes to a consistent and
+// serviceable destination of the catalog, see
+// domain.LocationCatalog.CheckDestination.
+func (s *LocationService) CheckDestination(ctx context.Context, order *domain.Order) error {
+	catalog, err := s.locations(ctx)
+	if err != nil {
+		return err
+	}
+	return catalog.CheckDestination(order)
+}
+
+func (s *LocationService) locations(ctx context.Context) (*domain.LocationCatalog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.catalog != nil && time.Since(s.loadedAt) < locationRefresh {
+		return s.catalog, nil
+	}
+	catalog, err := s.repo.ListLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.catalog, s.loadedAt = catalog, time.Now()
+	return catalog, nil
+}
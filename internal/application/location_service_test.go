@@ -0,0 +1,70 @@
+// internal/application/location_service_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+func TestLocationService_Seed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewLocationService(mockRepo)
+	ctx := context.Background()
+
+	seed := `{"cities": [{"id": 1, "name": "Dhaka", "zones": [
+		{"id": 1, "name": "Banani", "areas": [{"id": 1, "name": "Banani DOHS"}, {"id": 2, "name": "Kamal Ataturk Avenue", "serviceable": false}]}
+	]}]}`
+	var saved *domain.LocationCatalog
+	mockRepo.EXPECT().SaveLocations(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *domain.LocationCatalog) error {
+		saved = c
+		return nil
+	})
+	if err := svc.Seed(ctx, strings.NewReader(seed)); err != nil {
+		t.Fatalf("Seed() error: %v", err)
+	}
+	want := []domain.Area{{ID: 1, ZoneID: 1, Name: "Banani DOHS", Serviceable: true}, {ID: 2, ZoneID: 1, Name: "Kamal Ataturk Avenue"}}
+	if len(saved.Cities) != 1 || len(saved.Zones) != 1 || saved.Zones[0].CityID != 1 || len(saved.Areas) != 2 || saved.Areas[0] != want[0] || saved.Areas[1] != want[1] {
+		t.Errorf("Seed() saved %+v, want Dhaka, Banani and its two areas", saved)
+	}
+
+	for _, bad := range []string{
+		`{"cities": [{"id": 1, "name": "Dhaka", "zones": [{"id": 1, "name": "Banani"}, {"id": 1, "name": "Gulshan"}]}]}`,
+		`{"cities": [{"id": 1, "name": "Dhaka", "thanas": []}]}`,
+		`not json`,
+	} {
+		if err := svc.Seed(ctx, strings.NewReader(bad)); !errors.Is(err, domain.ErrInvalidLocationCatalog) {
+			t.Errorf("Seed(%s) error = %v, want %v", bad, err, domain.ErrInvalidLocationCatalog)
+		}
+	}
+}
+
+func TestLocationService_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := defaultLocations(mockRepo)
+	ctx := context.Background()
+
+	if cities, err := svc.ListCities(ctx); err != nil || len(cities) != 2 {
+		t.Errorf("ListCities() = %v, %v, want two cities", cities, err)
+	}
+	if zones, err := svc.ListZones(ctx, 1); err != nil || len(zones) != 1 || zones[0].Name != "Banani" {
+		t.Errorf("ListZones(1) = %v, %v, want Banani", zones, err)
+	}
+	if zones, err := svc.ListZones(ctx, 2); err != nil || len(zones) != 0 {
+		t.Errorf("ListZones(2) = %v, %v, want none", zones, err)
+	}
+	if areas, err := svc.ListAreas(ctx, 1); err != nil || len(areas) != 1 || !areas[0].Serviceable {
+		t.Errorf("ListAreas(1) = %v, %v, want Banani DOHS", areas, err)
+	}
+}
@@ -0,0 +1,124 @@
+// internal/application/login_throttle.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+// LockoutConfig controls how failed logins are throttled. Each failure within
+// Window doubles the wait before the next attempt, starting at BaseDelay; at
+// MaxAttempts failures the username (or MaxAttemptsPerIP for a client IP) is
+// locked for LockoutDuration.
+type LockoutConfig struct {
+	MaxAttempts      int64
+	MaxAttemptsPerIP int64
+	BaseDelay        time.Duration
+	LockoutDuration  time.Duration
+	Window           time.Duration
+}
+
+func DefaultLockoutConfig() LockoutConfig {
+	return LockoutConfig{
+		MaxAttempts:      5,
+		MaxAttemptsPerIP: 20,
+		BaseDelay:        time.Second,
+		LockoutDuration:  15 * time.Minute,
+		Window:           15 * time.Minute,
+	}
+}
+
+// loginThrottle tracks failed logins in the cache so the limits hold across
+// replicas. Without a cache it does nothing.
+type loginThrottle struct {
+	cache ports.CachePort
+	cfg   LockoutConfig
+}
+
+func failKey(scope, id string) string { return fmt.Sprintf("login:fail:%s:%s", scope, id) }
+func lockKey(scope, id string) string { return fmt.Sprintf("login:lock:%s:%s", scope, id) }
+
+// check returns a *domain.LockoutError if the username or IP is currently
+// blocked.
+func (t *loginThrottle) check(ctx context.Context, username, ip string) error {
+	if t.cache == nil {
+		return nil
+	}
+	keys := []string{lockKey("user", username)}
+	if ip != "" {
+		keys = append(keys, lockKey("ip", ip))
+	}
+	var retryAfter time.Duration
+	for _, key := range keys {
+		data, err := t.cache.Get(ctx, key)
+		if err != nil {
+			// Missing key or cache outage: do not block logins
+			continue
+		}
+		var until int64
+		if json.Unmarshal(data, &until) != nil {
+			continue
+		}
+		if wait := time.Until(time.UnixMilli(until)); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	if retryAfter > 0 {
+		return &domain.LockoutError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// failure records a failed attempt and blocks further attempts for the
+// backoff delay, or for the lockout duration once the limit is reached.
+func (t *loginThrottle) failure(ctx context.Context, username, ip string) {
+	if t.cache == nil {
+		return
+	}
+	t.record(ctx, "user", username, t.cfg.MaxAttempts)
+	if ip != "" {
+		t.record(ctx, "ip", ip, t.cfg.MaxAttemptsPerIP)
+	}
+}
+
+func (t *loginThrottle) record(ctx context.Context, scope, id string, max int64) {
+	n, err := t.cache.Increment(ctx, failKey(scope, id), t.cfg.Window)
+	if err != nil {
+		fmt.Printf("Failed to record login failure: %v\n", err)
+		return
+	}
+	wait := t.cfg.LockoutDuration
+	if n < max {
+		wait = t.cfg.BaseDelay << (n - 1)
+		if wait <= 0 || wait > t.cfg.LockoutDuration {
+			wait = t.cfg.LockoutDuration
+		}
+	}
+	until := time.Now().Add(wait).UnixMilli()
+	if err := t.cache.SetWithTTL(ctx, lockKey(scope, id), until, wait); err != nil {
+		fmt.Printf("Failed to record login lockout: %v\n", err)
+	}
+}
+
+// success clears the username's failures. IP counters are left alone so one
+// valid account cannot be used to reset an attacker's budget.
+func (t *loginThrottle) success(ctx context.Context, username string) {
+	if err := t.unlock(ctx, username); err != nil {
+		fmt.Printf("Failed to reset login failures: %v\n", err)
+	}
+}
+
+func (t *loginThrottle) unlock(ctx context.Context, username string) error {
+	if t.cache == nil {
+		return nil
+	}
+	if err := t.cache.Delete(ctx, failKey("user", username)); err != nil {
+		return err
+	}
+	return t.cache.Delete(ctx, lockKey("user", username))
+}
@@ -0,0 +1,158 @@
+// internal/application/login_throttle_test.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// memoryCache is a minimal in-process ports.CachePort honouring TTLs.
+type memoryCache struct {
+	mu      sync.Mutex
+	values  map[string][]byte
+	expires map[string]time.Time
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: map[string][]byte{}, expires: map[string]time.Time{}}
+}
+
+func (c *memoryCache) live(key string) bool {
+	if exp, ok := c.expires[key]; ok && time.Now().After(exp) {
+		delete(c.values, key)
+		delete(c.expires, key)
+	}
+	_, ok := c.values[key]
+	return ok
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.live(key) {
+		return nil, errors.New("cache miss")
+	}
+	return c.values[key], nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, value interface{}) error {
+	return c.SetWithTTL(ctx, key, value, time.Hour)
+}
+
+func (c *memoryCache) SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.values[key] = data
+	c.expires[key] = time.Now().Add(ttl)
+	return nil
+}
+
+func (c *memoryCache) Exists(ctx context.Context, key string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.live(key), nil
+}
+
+func (c *memoryCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var n int64
+	if c.live(key) {
+		json.Unmarshal(c.values[key], &n)
+	} else {
+		c.expires[key] = time.Now().Add(ttl)
+	}
+	n++
+	c.values[key], _ = json.Marshal(n)
+	return n, nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	delete(c.expires, key)
+	return nil
+}
+
+func (c *memoryCache) DeleteByPrefix(ctx context.Context, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.values {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.values, k)
+			delete(c.expires, k)
+		}
+	}
+	return nil
+}
+
+func (c *memoryCache) Ping(ctx context.Context) error { return nil }
+
+func TestAuthService_LoginLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	cfg := LockoutConfig{MaxAttempts: 3, MaxAttemptsPerIP: 4, BaseDelay: 50 * time.Millisecond, LockoutDuration: time.Hour, Window: time.Hour}
+	svc := NewAuthService(mockRepo, ports.NewMockRevocationStorePort(ctrl), newMemoryCache(), nil, cfg)
+
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass1"), bcrypt.MinCost)
+	user := &domain.User{ID: 1, Username: "testuser@example.com", Password: string(hashed)}
+	mockRepo.EXPECT().FindUserByUsername(gomock.Any(), "testuser@example.com").Return(user, nil).AnyTimes()
+	mockRepo.EXPECT().FindUserByUsername(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(nil, nil).AnyTimes()
+	mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	ctx := context.Background()
+	client := domain.ClientInfo{IP: "10.0.0.1"}
+	var lockout *domain.LockoutError
+
+	// A retry right after a failure has to wait for the backoff delay
+	if _, _, err := svc.Login(ctx, user.Username, "wrong", client); err == nil || errors.As(err, &lockout) {
+		t.Fatalf("Login() error = %v, want invalid credentials", err)
+	}
+	if _, _, err := svc.Login(ctx, user.Username, "securepass1", client); !errors.As(err, &lockout) {
+		t.Fatalf("Login() during backoff error = %v, want LockoutError", err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, _, err := svc.Login(ctx, user.Username, "wrong", client); errors.As(err, &lockout) {
+		t.Fatalf("Login() after backoff error = %v, want invalid credentials", err)
+	}
+	time.Sleep(110 * time.Millisecond)
+	svc.Login(ctx, user.Username, "wrong", client)
+
+	// Third failure locks the account, even for the right password
+	if _, _, err := svc.Login(ctx, user.Username, "securepass1", client); !errors.As(err, &lockout) || lockout.RetryAfter < 59*time.Minute {
+		t.Fatalf("Login() locked account error = %v, want LockoutError for the lockout duration", err)
+	}
+
+	if err := svc.UnlockAccount(ctx, user.Username); err != nil {
+		t.Fatalf("UnlockAccount() error = %v", err)
+	}
+	if _, _, err := svc.Login(ctx, user.Username, "securepass1", domain.ClientInfo{IP: "10.0.0.2"}); err != nil {
+		t.Fatalf("Login() after unlock error = %v", err)
+	}
+
+	// The IP that made three failures is blocked after one more, for any username
+	time.Sleep(5 * time.Millisecond)
+	svc.Login(ctx, "other@example.com", "guess", client)
+	if _, _, err := svc.Login(ctx, "third@example.com", "guess", client); !errors.As(err, &lockout) {
+		t.Fatalf("Login() from locked IP error = %v, want LockoutError", err)
+	}
+}
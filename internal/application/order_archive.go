@@ -0,0 +1,110 @@
+// internal/application/order_archive.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const maxArchiveBatch = 100
+
+// ArchiveOrders hides orders of the user from ListOrders unless archived
+// orders are asked for. Only orders in a final status can be archived; the
+// consignment IDs of the orders that were archived are returned, others are
+// skipped.
+func (s *OrderService) ArchiveOrders(ctx context.Context, userID int64, consignmentIDs []string) ([]string, error) {
+	ids, err := archiveBatch(consignmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	archived, err := s.repo.ArchiveOrders(ctx, userID, ids, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if len(archived) > 0 {
+		s.invalidateOrders(ctx, userID)
+	}
+	return archived, nil
+}
+
+// UnarchiveOrders returns archived orders of the user to the default order
+// list, and returns the consignment IDs of the orders that were archived.
+func (s *OrderService) UnarchiveOrders(ctx context.Context, userID int64, consignmentIDs []string) ([]string, error) {
+	ids, err := archiveBatch(consignmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	restored, err := s.repo.UnarchiveOrders(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(restored) > 0 {
+		s.invalidateOrders(ctx, userID)
+	}
+	return restored, nil
+}
+
+// ArchiveFinalOrders archives the orders of all users that reached a final
+// status more than maxAge ago, and returns how many it archived.
+func (s *OrderService) ArchiveFinalOrders(ctx context.Context, maxAge time.Duration) (int, error) {
+	now := time.Now().UTC()
+	owners, err := s.repo.ArchiveFinalOrders(ctx, now.Add(-maxAge), now)
+	if err != nil {
+		return 0, err
+	}
+	seen := make(map[int64]bool)
+	for _, userID := range owners {
+		if !seen[userID] {
+			seen[userID] = true
+			s.invalidateOrders(ctx, userID)
+		}
+	}
+	return len(owners), nil
+}
+
+// RunAutoArchive calls ArchiveFinalOrders every interval, hourly if interval
+// is not positive, until ctx is done.
+func (s *OrderService) RunAutoArchive(ctx context.Context, maxAge, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := s.ArchiveFinalOrders(ctx, maxAge)
+		if err != nil {
+			fmt.Printf("Failed to archive orders: %v\n", err)
+		} else if n > 0 {
+			fmt.Printf("Archived %d orders\n", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// archiveBatch trims and deduplicates the consignment IDs of an archive
+// request.
+func archiveBatch(consignmentIDs []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, id := range consignmentIDs {
+		id = strings.TrimSpace(id)
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no consignment ids given")
+	}
+	if len(ids) > maxArchiveBatch {
+		return nil, fmt.Errorf("at most %d consignment ids per request", maxArchiveBatch)
+	}
+	return ids, nil
+}
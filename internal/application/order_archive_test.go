@@ -0,0 +1,73 @@
+// internal/application/order_archive_test.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+func TestOrderService_ArchiveOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	var invalidated []string
+	mockCache := &mockCache{
+		delete: func(ctx context.Context, prefix string) error { invalidated = append(invalidated, prefix); return nil },
+	}
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	// Duplicates and blanks are dropped before they reach the repository
+	mockRepo.EXPECT().ArchiveOrders(gomock.Any(), int64(1), []string{"DA251021BNWWN1", "DA251021BNWWN2"}, gomock.Any()).Return([]string{"DA251021BNWWN1"}, nil)
+	archived, err := svc.ArchiveOrders(ctx, 1, []string{"DA251021BNWWN1", " ", "DA251021BNWWN2", "DA251021BNWWN1 "})
+	if err != nil || len(archived) != 1 {
+		t.Fatalf("ArchiveOrders() = %v, %v, want one archived order", archived, err)
+	}
+	if len(invalidated) != 1 || invalidated[0] != "orders:user:1" {
+		t.Errorf("invalidated cache prefixes = %v, want the user's orders", invalidated)
+	}
+
+	if _, err := svc.ArchiveOrders(ctx, 1, nil); err == nil {
+		t.Errorf("ArchiveOrders() without ids error = nil, want error")
+	}
+	tooMany := make([]string, maxArchiveBatch+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("DA251021BNWWN%d", i)
+	}
+	if _, err := svc.UnarchiveOrders(ctx, 1, tooMany); err == nil {
+		t.Errorf("UnarchiveOrders() with %d ids error = nil, want error", len(tooMany))
+	}
+}
+
+func TestOrderService_ArchiveFinalOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	var invalidated []string
+	mockCache := &mockCache{
+		delete: func(ctx context.Context, prefix string) error { invalidated = append(invalidated, prefix); return nil },
+	}
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+
+	maxAge := 30 * 24 * time.Hour
+	mockRepo.EXPECT().ArchiveFinalOrders(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, finalBefore, at time.Time) ([]int64, error) {
+		if got := at.Sub(finalBefore); got != maxAge {
+			t.Errorf("ArchiveFinalOrders() cutoff %v before now, want %v", got, maxAge)
+		}
+		return []int64{1, 2, 1}, nil
+	})
+	n, err := svc.ArchiveFinalOrders(context.Background(), maxAge)
+	if err != nil || n != 3 {
+		t.Fatalf("ArchiveFinalOrders() = %d, %v, want 3", n, err)
+	}
+	if len(invalidated) != 2 {
+		t.Errorf("invalidated cache prefixes = %v, want one per owner", invalidated)
+	}
+}
@@ -0,0 +1,221 @@
+// internal/application/order_bulk.go
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+)
+
+const (
+	// MaxBulkOrders is the most orders one bulk request may carry.
+	MaxBulkOrders = 1000
+	// bulkInsertBatch is the number of orders inserted per transaction.
+	bulkInsertBatch = 100
+)
+
+// BulkOrder is one row of a bulk order creation. Rows count from 1.
+type BulkOrder struct {
+	Row   int
+	Order *domain.Order
+}
+
+// BulkOrderResult is the outcome of one row: the created order, or why the
+// row was rejected.
+type BulkOrderResult struct {
+	Row   int
+	Order *domain.Order
+	Err   error
+}
+
+// CreateOrders creates many orders of the user at once with the validation
+// and fees of CreateOrder. Valid rows are inserted in transactions of up to
+// bulkInsertBatch orders; invalid rows do not stop the others. The results
+// are sorted by row.
+func (s *OrderService) CreateOrders(ctx context.Context, orders []BulkOrder, userID int64) ([]BulkOrderResult, error) {
+	if len(orders) > MaxBulkOrders {
+		return nil, fmt.Errorf("at most %d orders per request", MaxBulkOrders)
+	}
+	results := make([]BulkOrderResult, 0, len(orders))
+	var valid []BulkOrder
+	for _, o := range orders {
+		if err := s.prepareOrder(ctx, o.Order, userID); err != nil {
+			results = append(results, BulkOrderResult{Row: o.Row, Err: err})
+			continue
+		}
+		valid = append(valid, o)
+	}
+
+	created := 0
+	for start := 0; start < len(valid); start += bulkInsertBatch {
+		batch := valid[start:min(start+bulkInsertBatch, len(valid))]
+		batchOrders := make([]*domain.Order, len(batch))
+		for i, o := range batch {
+			batchOrders[i] = o.Order
+		}
+		if err := s.repo.CreateOrders(ctx, batchOrders); err != nil {
+			// One failing row rolls back its whole batch, so retry the rows
+			// one by one to tell which of them failed
+			for _, o := range batch {
+				if err := s.repo.CreateOrder(ctx, o.Order); err != nil {
+					results = append(results, BulkOrderResult{Row: o.Row, Err: err})
+					continue
+				}
+				results = append(results, BulkOrderResult{Row: o.Row, Order: o.Order})
+				created++
+			}
+			continue
+		}
+		for _, o := range batch {
+			results = append(results, BulkOrderResult{Row: o.Row, Order: o.Order})
+		}
+		created += len(batch)
+	}
+
+	if created > 0 {
+		s.invalidateOrders(ctx, userID)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Row < results[j].Row })
+	return results, nil
+}
+
+// orderCSVColumns are the columns of an order import, named like the fields
+// of CreateOrderRequest.
+var orderCSVColumns = map[string]func(o *domain.Order, value string) error{
+	"store_id":            intColumn(func(o *domain.Order, v int64) { o.StoreID = v }),
+	"merchant_order_id":   func(o *domain.Order, v string) error { o.MerchantOrderID = v; return nil },
+	"recipient_name":      func(o *domain.Order, v string) error { o.RecipientName = v; return nil },
+	"recipient_phone":     func(o *domain.Order, v string) error { o.RecipientPhone = v; return nil },
+	"recipient_address":   func(o *domain.Order, v string) error { o.RecipientAddress = v; return nil },
+	"recipient_city":      intColumn(func(o *domain.Order, v int64) { o.RecipientCity = v }),
+	"recipient_zone":      intColumn(func(o *domain.Order, v int64) { o.RecipientZone = v }),
+	"recipient_area":      intColumn(func(o *domain.Order, v int64) { o.RecipientArea = v }),
+	"delivery_type":       intColumn(func(o *domain.Order, v int64) { o.DeliveryType = v }),
+	"item_type":           intColumn(func(o *domain.Order, v int64) { o.ItemType = v }),
+	"special_instruction": func(o *domain.Order, v string) error { o.Instruction = v; return nil },
+	"item_quantity":       intColumn(func(o *domain.Order, v int64) { o.ItemQuantity = v }),
+	"item_weight":         floatColumn(func(o *domain.Order, v float64) { o.ItemWeight = v }),
+	"amount_to_collect":   moneyColumn(func(o *domain.Order, v domain.Money) { o.AmountToCollect = v }),
+	"item_description":    func(o *domain.Order, v string) error { o.Description = v; return nil },
+	"promo_code":          func(o *domain.Order, v string) error { o.PromoCode = v; return nil },
+}
+
+// requiredOrderCSVColumns must appear in the header of an order import.
+var requiredOrderCSVColumns = []string{"recipient_name", "recipient_phone", "recipient_address", "item_quantity", "item_weight", "amount_to_collect"}
+
+func intColumn(set func(o *domain.Order, v int64)) func(o *domain.Order, value string) error {
+	return func(o *domain.Order, value string) error {
+		if value == "" {
+			return nil
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.New("not a whole number")
+		}
+		set(o, v)
+		return nil
+	}
+}
+
+func floatColumn(set func(o *domain.Order, v float64)) func(o *domain.Order, value string) error {
+	return func(o *domain.Order, value string) error {
+		if value == "" {
+			return nil
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.New("not a number")
+		}
+		set(o, v)
+		return nil
+	}
+}
+
+func moneyColumn(set func(o *domain.Order, v domain.Money)) func(o *domain.Order, value string) error {
+	return func(o *domain.Order, value string) error {
+		if value == "" {
+			return nil
+		}
+		v, err := domain.ParseMoney(value)
+		if err != nil {
+			return errors.New("not an amount with at most two decimals")
+		}
+		set(o, v)
+		return nil
+	}
+}
+
+// ParseOrdersCSV reads an order import. The first record is a header naming
+// the columns, in any order; see orderCSVColumns. Every following record is
+// one order, numbered from 1. Records that cannot be parsed are returned as
+// failed results, the others as orders for CreateOrders. An error is returned
+// only if the file as a whole is unusable.
+func ParseOrdersCSV(r io.Reader) ([]BulkOrder, []BulkOrderResult, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("csv file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+	columns := make([]string, len(header))
+	seen := make(map[string]bool)
+	for i, name := range header {
+		// Spreadsheet exports may start with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := orderCSVColumns[name]; !ok {
+			return nil, nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		if seen[name] {
+			return nil, nil, fmt.Errorf("duplicate csv column %q", name)
+		}
+		seen[name] = true
+		columns[i] = name
+	}
+	for _, name := range requiredOrderCSVColumns {
+		if !seen[name] {
+			return nil, nil, fmt.Errorf("missing csv column %q", name)
+		}
+	}
+
+	var orders []BulkOrder
+	var failed []BulkOrderResult
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if row > MaxBulkOrders {
+			return nil, nil, fmt.Errorf("at most %d orders per request", MaxBulkOrders)
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, err
+			}
+			failed = append(failed, BulkOrderResult{Row: row, Err: parseErr.Err})
+			continue
+		}
+		order := &domain.Order{}
+		for i, value := range record {
+			if err := orderCSVColumns[columns[i]](order, strings.TrimSpace(value)); err != nil {
+				failed = append(failed, BulkOrderResult{Row: row, Err: fmt.Errorf("%s: %w", columns[i], err)})
+				order = nil
+				break
+			}
+		}
+		if order != nil {
+			orders = append(orders, BulkOrder{Row: row, Order: order})
+		}
+	}
+	return orders, failed, nil
+}
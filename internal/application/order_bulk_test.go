@@ -0,0 +1,105 @@
+// internal/application/order_bulk_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+func bulkTestOrder(phone string) *domain.Order {
+	return &domain.Order{
+		RecipientName:    "John Doe",
+		RecipientPhone:   phone,
+		RecipientAddress: "123 Street",
+		RecipientCity:    1,
+		ItemQuantity:     1,
+		ItemWeight:       0.5,
+		AmountToCollect:  100000,
+	}
+}
+
+func TestOrderService_CreateOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	var invalidated []string
+	mockCache := &mockCache{
+		delete: func(ctx context.Context, prefix string) error { invalidated = append(invalidated, prefix); return nil },
+	}
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	defaultStore(mockRepo)
+
+	var orders []BulkOrder
+	for i := 1; i <= bulkInsertBatch+2; i++ {
+		orders = append(orders, BulkOrder{Row: i, Order: bulkTestOrder("01712345678")})
+	}
+	orders[1].Order.RecipientPhone = "123"
+
+	// The first batch is inserted at once; the second fails and is retried
+	// row by row
+	mockRepo.EXPECT().CreateOrders(gomock.Any(), gomock.Len(bulkInsertBatch)).Return(nil)
+	mockRepo.EXPECT().CreateOrders(gomock.Any(), gomock.Len(1)).Return(errors.New("duplicate key"))
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), orders[bulkInsertBatch+1].Order).Return(errors.New("duplicate key"))
+
+	results, err := svc.CreateOrders(context.Background(), orders, 1)
+	if err != nil {
+		t.Fatalf("CreateOrders() error: %v", err)
+	}
+	if len(results) != len(orders) {
+		t.Fatalf("CreateOrders() returned %d results, want %d", len(results), len(orders))
+	}
+	for i, r := range results {
+		wantErr := i == 1 || i == bulkInsertBatch+1
+		if r.Row != i+1 || (r.Err != nil) != wantErr {
+			t.Errorf("result %d = row %d, error %v, want row %d failed %v", i, r.Row, r.Err, i+1, wantErr)
+		}
+		if r.Err == nil && (r.Order.Status != domain.OrderStatusPending || r.Order.UserID != 1 || r.Order.DeliveryFee != 6000) {
+			t.Errorf("result %d order = %+v, want a pending order of user 1 with fees", i, r.Order)
+		}
+	}
+	if len(invalidated) != 1 {
+		t.Errorf("invalidated cache prefixes = %v, want one", invalidated)
+	}
+
+	if _, err := svc.CreateOrders(context.Background(), make([]BulkOrder, MaxBulkOrders+1), 1); err == nil {
+		t.Errorf("CreateOrders() with %d orders error = nil, want error", MaxBulkOrders+1)
+	}
+}
+
+func TestParseOrdersCSV(t *testing.T) {
+	csv := "\ufeffRecipient_Name,recipient_phone,recipient_address,recipient_city,item_quantity,item_weight,amount_to_collect\n" +
+		"John Doe,01712345678,\"123 Street, Dhaka\",1,1,0.5,1000\n" +
+		"Jane Doe,01712345679,456 Street,one,1,0.5,1000\n" +
+		"Jim Doe,01712345670,789 Street,2,2,1.5,500\n"
+	orders, failed, err := ParseOrdersCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("ParseOrdersCSV() error: %v", err)
+	}
+	if len(orders) != 2 || orders[0].Row != 1 || orders[1].Row != 3 {
+		t.Fatalf("ParseOrdersCSV() orders = %+v, want rows 1 and 3", orders)
+	}
+	if o := orders[0].Order; o.RecipientAddress != "123 Street, Dhaka" || o.RecipientCity != 1 || o.ItemWeight != 0.5 {
+		t.Errorf("row 1 order = %+v", o)
+	}
+	if len(failed) != 1 || failed[0].Row != 2 || !strings.Contains(failed[0].Err.Error(), "recipient_city") {
+		t.Errorf("ParseOrdersCSV() failed = %+v, want row 2 failing on recipient_city", failed)
+	}
+
+	for name, header := range map[string]string{
+		"unknown":   "recipient_name,recipient_phone,recipient_address,item_quantity,item_weight,amount_to_collect,colour\n",
+		"duplicate": "recipient_name,recipient_name,recipient_phone,recipient_address,item_quantity,item_weight,amount_to_collect\n",
+		"missing":   "recipient_name,recipient_phone,recipient_address,item_quantity,item_weight\n",
+		"empty":     "",
+	} {
+		if _, _, err := ParseOrdersCSV(strings.NewReader(header)); err == nil {
+			t.Errorf("ParseOrdersCSV() with %s column error = nil, want error", name)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// internal/application/order_idempotency.go
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+)
+
+const (
+	// DefaultIdempotencyRetention is how long an idempotency key is
+	// remembered unless SetIdempotencyRetention says otherwise.
+	DefaultIdempotencyRetention = 24 * time.Hour
+	maxIdempotencyKeyLength     = 255
+)
+
+// SetIdempotencyRetention sets how long idempotency keys are remembered.
+// Non-positive values keep the current retention.
+func (s *OrderService) SetIdempotencyRetention(d time.Duration) {
+	if d > 0 {
+		s.idempotencyRetention = d
+	}
+}
+
+// CreateOrderIdempotent creates an order like CreateOrder, at most once per
+// idempotency key of the user within the retention window. Repeating the
+// request with the same key returns the order the first request created;
+// using the key for a different order fails with ErrIdempotencyKeyReused.
+// Without a key the order is always created.
+func (s *OrderService) CreateOrderIdempotent(ctx context.Context, req *domain.Order, userID int64, key string) (*domain.Order, error) {
+	if key == "" {
+		return s.CreateOrder(ctx, req, userID)
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("idempotency key is longer than %d characters", maxIdempotencyKeyLength)
+	}
+	// Hash the order as the client sent it, before fees and IDs are filled in
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(payload)
+	hash := hex.EncodeToString(sum[:])
+
+	now := time.Now().UTC()
+	expiredBefore := now.Add(-s.idempotencyRetention)
+	if order, err := s.replayOrder(ctx, userID, key, hash, expiredBefore); order != nil || err != nil {
+		return order, err
+	}
+
+	if err := s.prepareOrder(ctx, req, userID); err != nil {
+		return nil, err
+	}
+	response, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.CreateOrderWithIdempotencyKey(ctx, req, &domain.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: hash,
+		Response:    response,
+		CreatedAt:   now,
+	}, expiredBefore)
+	if errors.Is(err, domain.ErrIdempotencyKeyExists) {
+		// A concurrent request with the same key got there first
+		order, err := s.replayOrder(ctx, userID, key, hash, expiredBefore)
+		if order == nil && err == nil {
+			err = domain.ErrIdempotencyKeyExists
+		}
+		return order, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateOrders(ctx, userID)
+	return req, nil
+}
+
+// replayOrder returns the order created under the key of the user, or nil if
+// the key is unused.
+func (s *OrderService) replayOrder(ctx context.Context, userID int64, key, hash string, expiredBefore time.Time) (*domain.Order, error) {
+	k, err := s.repo.FindIdempotencyKey(ctx, userID, key, expiredBefore)
+	if err != nil || k == nil {
+		return nil, err
+	}
+	if k.RequestHash != hash {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+	var order domain.Order
+	if err := json.Unmarshal(k.Response, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// RunIdempotencyKeyPurge deletes expired idempotency keys every interval
+// until ctx is done.
+func (s *OrderService) RunIdempotencyKeyPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.repo.DeleteIdempotencyKeys(ctx, time.Now().UTC().Add(-s.idempotencyRetention)); err != nil {
+			fmt.Printf("Failed to delete idempotency keys: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// internal/application/order_idempotency_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+func TestOrderService_CreateOrderIdempotent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, nil, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	defaultStore(mockRepo)
+	ctx := context.Background()
+
+	var stored *domain.IdempotencyKey
+	mockRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(1), "retry-1", gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().CreateOrderWithIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *domain.Order, key *domain.IdempotencyKey, expiredBefore time.Time) error {
+		if got := key.CreatedAt.Sub(expiredBefore); got != DefaultIdempotencyRetention {
+			t.Errorf("CreateOrderWithIdempotencyKey() retention = %v, want %v", got, DefaultIdempotencyRetention)
+		}
+		stored = key
+		return nil
+	})
+	created, err := svc.CreateOrderIdempotent(ctx, bulkTestOrder("01712345678"), 1, "retry-1")
+	if err != nil || created.ConsignmentID == "" {
+		t.Fatalf("CreateOrderIdempotent() = %+v, %v, want a created order", created, err)
+	}
+
+	// A retry returns the first order without creating another one
+	mockRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(1), "retry-1", gomock.Any()).Return(stored, nil).Times(2)
+	replayed, err := svc.CreateOrderIdempotent(ctx, bulkTestOrder("01712345678"), 1, "retry-1")
+	if err != nil || replayed.ConsignmentID != created.ConsignmentID || replayed.DeliveryFee != created.DeliveryFee {
+		t.Fatalf("CreateOrderIdempotent() retry = %+v, %v, want order %s", replayed, err, created.ConsignmentID)
+	}
+
+	// The same key with another payload is rejected
+	if _, err := svc.CreateOrderIdempotent(ctx, bulkTestOrder("01712345679"), 1, "retry-1"); !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+		t.Errorf("CreateOrderIdempotent() different payload error = %v, want %v", err, domain.ErrIdempotencyKeyReused)
+	}
+}
+
+func TestOrderService_CreateOrderIdempotent_Concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, nil, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	defaultStore(mockRepo)
+
+	// Another request inserted the key between the lookup and the insert
+	var key *domain.IdempotencyKey
+	gomock.InOrder(
+		mockRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(1), "retry-1", gomock.Any()).Return(nil, nil),
+		mockRepo.EXPECT().CreateOrderWithIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *domain.Order, k *domain.IdempotencyKey, _ time.Time) error {
+			key = k
+			return domain.ErrIdempotencyKeyExists
+		}),
+		mockRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(1), "retry-1", gomock.Any()).DoAndReturn(func(context.Context, int64, string, time.Time) (*domain.IdempotencyKey, error) {
+			return &domain.IdempotencyKey{UserID: 1, Key: "retry-1", RequestHash: key.RequestHash, Response: []byte(`{"ConsignmentID":"DA251021BNWWN1","Status":"Pending"}`)}, nil
+		}),
+	)
+	got, err := svc.CreateOrderIdempotent(context.Background(), bulkTestOrder("01712345678"), 1, "retry-1")
+	if err != nil || got.ConsignmentID != "DA251021BNWWN1" {
+		t.Fatalf("CreateOrderIdempotent() = %+v, %v, want the order of the first request", got, err)
+	}
+}
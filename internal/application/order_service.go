@@ -3,10 +3,14 @@ package application
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	// "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/redis"
@@ -15,91 +19,173 @@ import (
 )
 
 type OrderService struct {
-	repo  ports.OrderRepositoryPort
-	cache ports.CachePort
+	repo                 ports.OrderRepositoryPort
+	cache                ports.CachePort
+	ids                  ports.IDGeneratorPort
+	pricing              *PricingService
+	locations            *LocationService
+	idempotencyRetention time.Duration
 }
 
-func NewOrderService(repo ports.OrderRepositoryPort, cache ports.CachePort) *OrderService {
-	return &OrderService{repo: repo, cache: cache}
+func NewOrderService(repo ports.OrderRepositoryPort, cache ports.CachePort, ids ports.IDGeneratorPort, pricing *PricingService, locations *LocationService) *OrderService {
+	return &OrderService{repo: repo, cache: cache, ids: ids, pricing: pricing, locations: locations, idempotencyRetention: DefaultIdempotencyRetention}
 }
 
 func (s *OrderService) CreateOrder(ctx context.Context, req *domain.Order, userID int64) (*domain.Order, error) {
-	if req.RecipientName == "" || req.RecipientPhone == "" || req.RecipientAddress == "" || req.ItemQuantity == 0 || req.ItemWeight == 0 || req.AmountToCollect == 0 {
-		return nil, errors.New("missing required fields")
+	if err := s.prepareOrder(ctx, req, userID); err != nil {
+		return nil, err
 	}
-	phoneRegex := regexp.MustCompile(`^(01)[3-9]{1}[0-9]{8}$`)
-	if !phoneRegex.MatchString(req.RecipientPhone) {
-		return nil, errors.New("invalid phone number")
+	err := s.repo.CreateOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateOrders(ctx, userID)
+	return req, nil
+}
+
+var phoneRegex = regexp.MustCompile(`^(01)[3-9]{1}[0-9]{8}$`)
+
+// prepareOrder validates a new order of userID and its destination, and fills
+// in its store details, its fees from the current rate card, its consignment
+// ID and initial status.
+func (s *OrderService) prepareOrder(ctx context.Context, req *domain.Order, userID int64) error {
+	if err := validateOrder(req); err != nil {
+		return err
+	}
+	if err := s.locations.CheckDestination(ctx, req); err != nil {
+		return err
 	}
 	if req.RecipientAddress == "" {
 		req.RecipientAddress = "banani, gulshan 2, dhaka, bangladesh"
 	}
 
-	baseFee := 60.0
-	if req.RecipientCity != 1 {
-		baseFee = 100.0
+	now := time.Now().UTC()
+	req.UserID = userID
+	store, err := orderStore(ctx, s.repo, req.StoreID, userID)
+	if err != nil {
+		return err
 	}
-	deliveryFee := baseFee
-	if req.ItemWeight > 0.5 && req.ItemWeight <= 1 {
-		deliveryFee = 70.0
-	} else if req.ItemWeight > 1 {
-		extraKg := req.ItemWeight - 1
-		deliveryFee = baseFee + 10 + (extraKg * 15)
+	applyStore(req, store)
+	if _, err := s.applyFees(ctx, req, now); err != nil {
+		return err
 	}
-	req.DeliveryFee = deliveryFee
-	req.DeliveryCharge = deliveryFee
-	req.CODFee = req.AmountToCollect * 0.01
-	req.TotalFee = req.DeliveryFee + req.CODFee
-	req.CODAmount = req.AmountToCollect
-	req.OrderAmount = req.AmountToCollect
 
-	req.StoreName = "Default Store"
-	req.StoreContactPhone = "123456789"
 	req.OrderType = "Delivery"
 	req.OrderTypeID = 1
-	req.PromoDiscount = 0
-	req.Discount = 0
 
-	req.ConsignmentID = fmt.Sprintf("DA%vBNWWN%d", time.Now().Format("060102"), time.Now().UnixNano()%1000)
-	req.CreatedAt = time.Now()
-	req.Status = "Pending"
-	req.UserID = userID
+	id, err := s.ids.NewConsignmentID(ctx)
+	if err != nil {
+		return err
+	}
+	req.ConsignmentID = id
+	req.CreatedAt = now
+	req.Status = domain.OrderStatusPending
+	return nil
+}
 
-	err := s.repo.CreateOrder(ctx, req)
+// applyStore books an order for store, taking the store's default delivery
+// type if the order has none.
+func applyStore(req *domain.Order, store *domain.Store) {
+	req.StoreID = store.ID
+	req.StoreName = store.Name
+	req.StoreContactPhone = store.ContactPhone
+	if req.DeliveryType == 0 {
+		req.DeliveryType = store.DefaultDeliveryType
+	}
+}
+
+// validateOrder checks the fields a merchant enters for an order.
+func validateOrder(req *domain.Order) error {
+	if req.RecipientName == "" || req.RecipientPhone == "" || req.RecipientAddress == "" || req.ItemQuantity == 0 || req.ItemWeight == 0 || req.AmountToCollect == 0 {
+		return errors.New("missing required fields")
+	}
+	if !phoneRegex.MatchString(req.RecipientPhone) {
+		return errors.New("invalid phone number")
+	}
+	return nil
+}
+
+// applyFees prices an order as of t, discounts included, and records the fees
+// on it. Orders and quotes are both priced here, so a quote always matches the
+// order booked with the same fields at the same time.
+func (s *OrderService) applyFees(ctx context.Context, req *domain.Order, t time.Time) (domain.Fees, error) {
+	req.PromoCode = domain.NormalizePromoCode(req.PromoCode)
+	fees, err := s.pricing.Price(ctx, req, t)
 	if err != nil {
-		return nil, err
+		return domain.Fees{}, err
 	}
+	setFees(req, fees)
+	return fees, nil
+}
 
-	// Invalidate cache for this user
-	if s.cache != nil {
-		err = s.cache.DeleteByPrefix(ctx, fmt.Sprintf("orders:user:%d", userID))
-		if err != nil {
-			fmt.Printf("Failed to invalidate cache: %v\n", err)
-		}
+// setFees records fees and the amounts derived from them on an order.
+func setFees(req *domain.Order, fees domain.Fees) {
+	req.CODAmount = req.AmountToCollect
+	req.OrderAmount = req.AmountToCollect
+	req.DeliveryFee = fees.DeliveryFee
+	req.DeliveryCharge = fees.DeliveryFee
+	req.CODFee = fees.CODFee
+	req.Discount = fees.Discount
+	req.PromoDiscount = fees.PromoDiscount
+	req.TotalFee = fees.TotalFee
+	req.RateCardVersion = fees.RateCardVersion
+}
+
+// QuoteDeliveryFee returns the fees an order of the user with the fields of
+// req would be charged if it were created now, without creating it.
+func (s *OrderService) QuoteDeliveryFee(ctx context.Context, req *domain.Order, userID int64) (domain.Fees, error) {
+	if req.ItemWeight <= 0 {
+		return domain.Fees{}, errors.New("item weight must be positive")
+	}
+	if req.AmountToCollect < 0 {
+		return domain.Fees{}, errors.New("amount to collect must not be negative")
 	}
+	if err := s.locations.CheckDestination(ctx, req); err != nil {
+		return domain.Fees{}, err
+	}
+	quoted := *req
+	quoted.UserID = userID
+	store, err := orderStore(ctx, s.repo, req.StoreID, userID)
+	if err != nil {
+		return domain.Fees{}, err
+	}
+	applyStore(&quoted, store)
+	return s.applyFees(ctx, &quoted, time.Now().UTC())
+}
 
-	return req, nil
+// invalidateOrders drops the cached order lists of a user.
+func (s *OrderService) invalidateOrders(ctx context.Context, userID int64) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.DeleteByPrefix(ctx, fmt.Sprintf("orders:user:%d", userID)); err != nil {
+		fmt.Printf("Failed to invalidate cache: %v\n", err)
+	}
 }
 
-func (s *OrderService) ListOrders(ctx context.Context, userID int64, limit, page int64) ([]*domain.Order, int64, error) {
+// ListOrders returns a page of the user's orders that match filter, and the
+// number of matching orders.
+func (s *OrderService) ListOrders(ctx context.Context, userID int64, filter domain.OrderFilter, limit, page int64) ([]*domain.Order, int64, error) {
 	if limit < 1 {
 		limit = 10
 	}
 	if page < 1 {
 		page = 1
 	}
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+	cacheKey := fmt.Sprintf("orders:user:%d:page:%d:limit:%d:filter:%s", userID, page, limit, orderFilterKey(filter))
 
+	type cachedData struct {
+		Orders []*domain.Order
+		Total  int64
+	}
 	// check cache
 	if s.cache != nil {
-		cacheKey := fmt.Sprintf("orders:user:%d:page:%d:limit:%d", userID, page, limit)
 		cached, err := s.cache.Get(ctx, cacheKey)
-		if err != nil {
-			// var orders []*domain.Order
-			// var total int64
-			type cachedData struct {
-				Orders []*domain.Order
-				Total  int64
-			}
+		if err == nil {
 			var data cachedData
 			if err := json.Unmarshal(cached, &data); err == nil {
 				return data.Orders, data.Total, nil
@@ -107,40 +193,225 @@ func (s *OrderService) ListOrders(ctx context.Context, userID int64, limit, page
 		}
 	}
 	// Cache miss, query database
-	orders, total, err := s.repo.ListOrders(ctx, userID, limit, page)
+	orders, total, err := s.repo.ListOrders(ctx, userID, filter, limit, page)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Cache result
 	if s.cache != nil {
-		cacheKey := fmt.Sprintf("orders:user:%d:page:%d:limit:%d", userID, page, limit)
-		data := struct {
-			Orders []*domain.Order
-			Total  int64
-		}{Orders: orders, Total: total}
-		if err := s.cache.Set(ctx, cacheKey, data); err != nil {
+		if err := s.cache.Set(ctx, cacheKey, cachedData{Orders: orders, Total: total}); err != nil {
 			// Log error but don't fail the operation
 			fmt.Printf("Failed to cache orders: %v\n", err)
 		}
 	}
-
-	// return s.repo.ListOrders(ctx, userID, limit, page)
 	return orders, total, nil
 }
 
+// ListOrdersByCursor returns up to limit of the user's orders that match
+// filter, starting after pageToken, and the token of the next page, which is
+// empty on the last page. An empty pageToken starts at the first order. The
+// total number of matching orders is only counted when withTotal is set and
+// is -1 otherwise. Unlike page numbers, tokens neither skip nor repeat orders
+// that are created while the client pages through the list.
+func (s *OrderService) ListOrdersByCursor(ctx context.Context, userID int64, filter domain.OrderFilter, pageToken string, limit int64, withTotal bool) (orders []*domain.Order, nextPageToken string, total int64, err error) {
+	if limit < 1 {
+		limit = 10
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, "", 0, err
+	}
+	if !filter.Keyset() {
+		return nil, "", 0, fmt.Errorf("%w: page tokens need the %s or %s sort", domain.ErrInvalidOrderFilter, domain.OrderSortCreatedDesc, domain.OrderSortCreatedAsc)
+	}
+	filterKey := orderFilterKey(filter)
+	var after *domain.OrderCursor
+	if pageToken != "" {
+		if after, err = decodeOrderPageToken(pageToken, filterKey); err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	// Fetch one more order than requested to learn whether there is a next page
+	orders, err = s.repo.ListOrdersAfter(ctx, userID, filter, after, limit+1)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if int64(len(orders)) > limit {
+		orders = orders[:limit]
+		last := orders[len(orders)-1]
+		nextPageToken = encodeOrderPageToken(domain.OrderCursor{CreatedAt: last.CreatedAt, ConsignmentID: last.ConsignmentID}, filterKey)
+	}
+
+	total = -1
+	if withTotal {
+		if total, err = s.repo.CountOrders(ctx, userID, filter); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	return orders, nextPageToken, total, nil
+}
+
+// orderPageToken is the content of a page token. Filter is the key of the
+// filter the token was issued for, so a token cannot continue a different
+// listing.
+type orderPageToken struct {
+	CreatedAt     time.Time `json:"c"`
+	ConsignmentID string    `json:"i"`
+	Filter        string    `json:"f"`
+}
+
+func encodeOrderPageToken(cursor domain.OrderCursor, filterKey string) string {
+	b, _ := json.Marshal(orderPageToken{CreatedAt: cursor.CreatedAt, ConsignmentID: cursor.ConsignmentID, Filter: filterKey})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeOrderPageToken(token, filterKey string) (*domain.OrderCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	var t orderPageToken
+	if err := json.Unmarshal(b, &t); err != nil || t.ConsignmentID == "" || t.Filter != filterKey {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return &domain.OrderCursor{CreatedAt: t.CreatedAt, ConsignmentID: t.ConsignmentID}, nil
+}
+
+// orderFilterKey identifies a validated filter in cache keys. Every field
+// takes part, so two different filters never share cached pages.
+func orderFilterKey(filter domain.OrderFilter) string {
+	b, _ := json.Marshal(filter)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+// GetOrder returns an order of the user together with its status history,
+// oldest change first.
+func (s *OrderService) GetOrder(ctx context.Context, consignmentID string, userID int64) (*domain.Order, []*domain.OrderStatusEvent, error) {
+	order, err := s.findOrder(ctx, consignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order.UserID != userID {
+		return nil, nil, domain.ErrOrderNotFound
+	}
+	history, err := s.repo.ListOrderStatusHistory(ctx, order.ConsignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, history, nil
+}
+
+// CancelOrder cancels an order of the user that has not been picked up yet.
 func (s *OrderService) CancelOrder(ctx context.Context, consignmentID string, userID int64) error {
-	err := s.repo.CancelOrder(ctx, consignmentID, userID)
+	order, err := s.findOrder(ctx, consignmentID)
 	if err != nil {
 		return err
 	}
+	if order.UserID != userID {
+		return domain.ErrOrderNotFound
+	}
+	return s.changeStatus(ctx, order, domain.OrderStatusCancelled, "cancelled by merchant", userID)
+}
 
-	// invalidate cache for this user
-	if s.cache != nil {
-		err = s.cache.DeleteByPrefix(ctx, fmt.Sprint("orders:user:%d", userID))
-		if err != nil {
-			fmt.Printf("failed to invalidate cache %v", err)
-		}
+// UpdateOrder replaces the recipient, parcel and amount to collect of a
+// pending order of the user with those of edit, validated like a new order,
+// and recomputes its fees with the rate card in effect now. The changed fields
+// are recorded in the order history. Once the order is picked up it fails
+// with ErrOrderNotEditable.
+func (s *OrderService) UpdateOrder(ctx context.Context, consignmentID string, edit *domain.Order, userID int64) (*domain.Order, error) {
+	order, err := s.findOrder(ctx, consignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, domain.ErrOrderNotFound
+	}
+	if !domain.EditableOrder(order.Status) {
+		return nil, fmt.Errorf("%w: order is %s", domain.ErrOrderNotEditable, order.Status)
+	}
+
+	updated := *order
+	domain.ApplyOrderEdit(&updated, edit)
+	if err := validateOrder(&updated); err != nil {
+		return nil, err
+	}
+	if err := s.locations.CheckDestination(ctx, &updated); err != nil {
+		return nil, err
+	}
+	fees, err := s.pricing.Reprice(ctx, &updated, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	setFees(&updated, fees)
+
+	changes := domain.OrderChanges(order, &updated)
+	if len(changes) == 0 {
+		return order, nil
+	}
+	event := &domain.OrderStatusEvent{
+		ConsignmentID: order.ConsignmentID,
+		FromStatus:    order.Status,
+		ToStatus:      order.Status,
+		ActorID:       userID,
+		Reason:        "edited by merchant",
+		Changes:       changes,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if err := s.repo.UpdateOrder(ctx, &updated, event); err != nil {
+		return nil, err
+	}
+	s.invalidateOrders(ctx, userID)
+	return &updated, nil
+}
+
+// UpdateOrderStatus moves an order to status on behalf of an operator. The
+// change is recorded in the order history with actorID and reason.
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, consignmentID, status, reason string, actorID int64) (*domain.Order, error) {
+	order, err := s.findOrder(ctx, consignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.changeStatus(ctx, order, status, strings.TrimSpace(reason), actorID); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// findOrder looks up an order by a consignment ID given by a client. IDs with
+// a wrong check digit fail with ErrInvalidConsignmentID without a lookup.
+func (s *OrderService) findOrder(ctx context.Context, consignmentID string) (*domain.Order, error) {
+	consignmentID = strings.ToUpper(strings.TrimSpace(consignmentID))
+	if !domain.ValidConsignmentID(consignmentID) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidConsignmentID, consignmentID)
+	}
+	order, err := s.repo.FindOrder(ctx, consignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+func (s *OrderService) changeStatus(ctx context.Context, order *domain.Order, status, reason string, actorID int64) error {
+	if err := domain.CheckOrderTransition(order.Status, status); err != nil {
+		return err
+	}
+	event := &domain.OrderStatusEvent{
+		ConsignmentID: order.ConsignmentID,
+		FromStatus:    order.Status,
+		ToStatus:      status,
+		ActorID:       actorID,
+		Reason:        reason,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if err := s.repo.UpdateOrderStatus(ctx, event); err != nil {
+		return err
 	}
+	order.Status = status
+	s.invalidateOrders(ctx, order.UserID)
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -15,10 +16,14 @@ import (
 )
 
 type mockCache struct {
-	get    func(ctx context.Context, key string) ([]byte, error)
-	set    func(ctx context.Context, key string, value interface{}) error
-	delete func(ctx context.Context, prefix string) error
-	ping   func(ctx context.Context) error
+	get        func(ctx context.Context, key string) ([]byte, error)
+	set        func(ctx context.Context, key string, value interface{}) error
+	setWithTTL func(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+	exists     func(ctx context.Context, key string) (bool, error)
+	increment  func(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	del        func(ctx context.Context, key string) error
+	delete     func(ctx context.Context, prefix string) error
+	ping       func(ctx context.Context) error
 }
 
 func (m *mockCache) Get(ctx context.Context, key string) ([]byte, error) {
@@ -29,6 +34,22 @@ func (m *mockCache) Set(ctx context.Context, key string, value interface{}) erro
 	return m.set(ctx, key, value)
 }
 
+func (m *mockCache) SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return m.setWithTTL(ctx, key, value, ttl)
+}
+
+func (m *mockCache) Exists(ctx context.Context, key string) (bool, error) {
+	return m.exists(ctx, key)
+}
+
+func (m *mockCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return m.increment(ctx, key, ttl)
+}
+
+func (m *mockCache) Delete(ctx context.Context, key string) error {
+	return m.del(ctx, key)
+}
+
 func (m *mockCache) DeleteByPrefix(ctx context.Context, prefix string) error {
 	return m.delete(ctx, prefix)
 }
@@ -37,6 +58,49 @@ func (m *mockCache) Ping(ctx context.Context) error {
 	return m.ping(ctx)
 }
 
+// defaultPricing prices orders with domain.DefaultRateCard for merchants
+// without a discount.
+func defaultPricing(repo *ports.MockOrderRepositoryPort) *PricingService {
+	repo.EXPECT().ListRateCards(gomock.Any()).Return([]*domain.RateCard{domain.DefaultRateCard()}, nil).AnyTimes()
+	repo.EXPECT().FindUserByID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return NewPricingService(repo)
+}
+
+// defaultLocations serves a catalog with cities 1 and 2, zone 1 of city 1 and
+// its serviceable area 1.
+func defaultLocations(repo *ports.MockOrderRepositoryPort) *LocationService {
+	repo.EXPECT().ListLocations(gomock.Any()).Return(&domain.LocationCatalog{
+		Cities: []domain.City{{ID: 1, Name: "Dhaka"}, {ID: 2, Name: "Chattogram"}},
+		Zones:  []domain.Zone{{ID: 1, CityID: 1, Name: "Banani"}},
+		Areas:  []domain.Area{{ID: 1, ZoneID: 1, Name: "Banani DOHS", Serviceable: true}},
+	}, nil).AnyTimes()
+	return NewLocationService(repo)
+}
+
+// defaultStore gives every merchant one store, with ten times their user ID
+// as its ID, which orders without a store ID are booked for.
+func defaultStore(repo *ports.MockOrderRepositoryPort) {
+	store := func(userID int64) *domain.Store {
+		return &domain.Store{ID: userID * 10, UserID: userID, Name: "Main Store", ContactPhone: "01812345678", PickupAddress: "Banani, Dhaka"}
+	}
+	repo.EXPECT().ListStores(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, userID int64) ([]*domain.Store, error) {
+		return []*domain.Store{store(userID)}, nil
+	}).AnyTimes()
+	repo.EXPECT().FindStore(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id int64) (*domain.Store, error) {
+		return store(id / 10), nil
+	}).AnyTimes()
+}
+
+// sequenceIDs issues consignment IDs from a counter.
+type sequenceIDs struct {
+	next int64
+}
+
+func (g *sequenceIDs) NewConsignmentID(ctx context.Context) (string, error) {
+	g.next++
+	return domain.NewConsignmentID("DA251021", g.next), nil
+}
+
 func TestOrderService_CreateOrder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -46,7 +110,8 @@ func TestOrderService_CreateOrder(t *testing.T) {
 		delete: func(ctx context.Context, prefix string) error { return nil },
 		ping:   func(ctx context.Context) error { return nil },
 	}
-	svc := NewOrderService(mockRepo, mockCache)
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	defaultStore(mockRepo)
 
 	validOrder := &domain.Order{
 		RecipientName:    "John Doe",
@@ -54,7 +119,7 @@ func TestOrderService_CreateOrder(t *testing.T) {
 		RecipientAddress: "123 Main St",
 		ItemQuantity:     5,
 		ItemWeight:       1.5,
-		AmountToCollect:  1000.0,
+		AmountToCollect:  100000,
 		RecipientCity:    1,
 	}
 
@@ -83,7 +148,7 @@ func TestOrderService_CreateOrder(t *testing.T) {
 				RecipientPhone: "01712345678",
 				ItemQuantity:   5,
 				ItemWeight:     1.5,
-				AmountToCollect: 1000.0,
+				AmountToCollect: 100000,
 			},
 			userID:    1,
 			mockSetup: func() {},
@@ -98,7 +163,7 @@ func TestOrderService_CreateOrder(t *testing.T) {
 				RecipientAddress: "123 Main St",
 				ItemQuantity:     5,
 				ItemWeight:       1.5,
-				AmountToCollect:  1000.0,
+				AmountToCollect:  100000,
 			},
 			userID:    1,
 			mockSetup: func() {},
@@ -145,7 +210,7 @@ func TestOrderService_ListOrders(t *testing.T) {
 	mockCache := &mockCache{
 		ping: func(ctx context.Context) error { return nil },
 	}
-	svc := NewOrderService(mockRepo, mockCache)
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
 
 	orders := []*domain.Order{
 		{
@@ -187,7 +252,7 @@ func TestOrderService_ListOrders(t *testing.T) {
 			page:   1,
 			mockSetup: func() {
 				mockCache.get = func(ctx context.Context, key string) ([]byte, error) { return nil, errors.New("cache miss") }
-				mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), int64(10), int64(1)).Return(orders, total, nil)
+				mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), gomock.Any(), int64(10), int64(1)).Return(orders, total, nil)
 				mockCache.set = func(ctx context.Context, key string, value interface{}) error { return nil }
 			},
 			wantErr: false,
@@ -199,7 +264,7 @@ func TestOrderService_ListOrders(t *testing.T) {
 			page:   1,
 			mockSetup: func() {
 				mockCache.get = func(ctx context.Context, key string) ([]byte, error) { return nil, errors.New("cache miss") }
-				mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), int64(10), int64(1)).Return(nil, int64(0), errors.New("db error"))
+				mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), gomock.Any(), int64(10), int64(1)).Return(nil, int64(0), errors.New("db error"))
 			},
 			wantErr: true,
 		},
@@ -210,7 +275,7 @@ func TestOrderService_ListOrders(t *testing.T) {
 			page:   1,
 			mockSetup: func() {
 				mockCache.get = func(ctx context.Context, key string) ([]byte, error) { return nil, errors.New("cache miss") }
-				mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), int64(10), int64(1)).Return(orders, total, nil)
+				mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), gomock.Any(), int64(10), int64(1)).Return(orders, total, nil)
 				mockCache.set = func(ctx context.Context, key string, value interface{}) error { return errors.New("cache set error") }
 			},
 			wantErr: false, // Cache error doesn't fail the operation
@@ -220,7 +285,7 @@ func TestOrderService_ListOrders(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
-			result, total, err := svc.ListOrders(context.Background(), tt.userID, tt.limit, tt.page)
+			result, total, err := svc.ListOrders(context.Background(), tt.userID, domain.OrderFilter{}, tt.limit, tt.page)
 			if tt.wantErr {
 				if err == nil {
 					t.Errorf("ListOrders() error = %v, wantErr %v", err, tt.wantErr)
@@ -237,6 +302,81 @@ func TestOrderService_ListOrders(t *testing.T) {
 	}
 }
 
+func TestOrderService_ListOrdersFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	var keys []string
+	mockCache := &mockCache{
+		get: func(ctx context.Context, key string) ([]byte, error) { return nil, errors.New("cache miss") },
+		set: func(ctx context.Context, key string, value interface{}) error { keys = append(keys, key); return nil },
+	}
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	want := domain.OrderFilter{Statuses: []string{domain.OrderStatusPending}, Search: "John", Sort: domain.OrderSortCreatedDesc}
+	mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), want, int64(10), int64(1)).Return(nil, int64(0), nil)
+	mockRepo.EXPECT().ListOrders(gomock.Any(), int64(1), gomock.Any(), int64(10), int64(1)).Return(nil, int64(0), nil)
+	if _, _, err := svc.ListOrders(ctx, 1, domain.OrderFilter{Statuses: []string{domain.OrderStatusPending}, Search: " John "}, 10, 1); err != nil {
+		t.Fatalf("ListOrders() error: %v", err)
+	}
+	if _, _, err := svc.ListOrders(ctx, 1, domain.OrderFilter{}, 10, 1); err != nil {
+		t.Fatalf("ListOrders() error: %v", err)
+	}
+	if len(keys) != 2 || keys[0] == keys[1] {
+		t.Errorf("cache keys = %v, want one per filter", keys)
+	}
+
+	if _, _, err := svc.ListOrders(ctx, 1, domain.OrderFilter{Statuses: []string{"Lost"}}, 10, 1); !errors.Is(err, domain.ErrInvalidOrderFilter) {
+		t.Errorf("ListOrders() unknown status error = %v, want %v", err, domain.ErrInvalidOrderFilter)
+	}
+	if _, _, err := svc.ListOrders(ctx, 1, domain.OrderFilter{Sort: "random"}, 10, 1); !errors.Is(err, domain.ErrInvalidOrderFilter) {
+		t.Errorf("ListOrders() unknown sort error = %v, want %v", err, domain.ErrInvalidOrderFilter)
+	}
+}
+
+func TestOrderService_ListOrdersByCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, nil, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	created := time.Date(2025, 10, 21, 10, 0, 0, 0, time.UTC)
+	orders := []*domain.Order{
+		{ConsignmentID: "DA251021BNWWN3", CreatedAt: created.Add(2 * time.Minute), UserID: 1},
+		{ConsignmentID: "DA251021BNWWN2", CreatedAt: created.Add(time.Minute), UserID: 1},
+		{ConsignmentID: "DA251021BNWWN1", CreatedAt: created, UserID: 1},
+	}
+	mockRepo.EXPECT().ListOrdersAfter(gomock.Any(), int64(1), gomock.Any(), nil, int64(3)).Return(orders, nil)
+	page, next, total, err := svc.ListOrdersByCursor(ctx, 1, domain.OrderFilter{}, "", 2, false)
+	if err != nil || len(page) != 2 || next == "" || total != -1 {
+		t.Fatalf("ListOrdersByCursor() = %d orders, %q, %d, %v, want 2 orders, a next page token and no total", len(page), next, total, err)
+	}
+
+	// The token continues after the last order of the page
+	cursor := &domain.OrderCursor{CreatedAt: orders[1].CreatedAt, ConsignmentID: "DA251021BNWWN2"}
+	mockRepo.EXPECT().ListOrdersAfter(gomock.Any(), int64(1), gomock.Any(), cursor, int64(3)).Return(orders[2:], nil)
+	mockRepo.EXPECT().CountOrders(gomock.Any(), int64(1), gomock.Any()).Return(int64(3), nil)
+	page, next, total, err = svc.ListOrdersByCursor(ctx, 1, domain.OrderFilter{}, next, 2, true)
+	if err != nil || len(page) != 1 || next != "" || total != 3 {
+		t.Fatalf("ListOrdersByCursor() last page = %d orders, %q, %d, %v, want 1 order, no token and total 3", len(page), next, total, err)
+	}
+
+	token := encodeOrderPageToken(*cursor, orderFilterKey(domain.OrderFilter{Sort: domain.OrderSortCreatedDesc}))
+	if _, _, _, err := svc.ListOrdersByCursor(ctx, 1, domain.OrderFilter{Search: "John"}, token, 2, false); !errors.Is(err, domain.ErrInvalidPageToken) {
+		t.Errorf("ListOrdersByCursor() token of another filter error = %v, want %v", err, domain.ErrInvalidPageToken)
+	}
+	if _, _, _, err := svc.ListOrdersByCursor(ctx, 1, domain.OrderFilter{}, "not-a-token", 2, false); !errors.Is(err, domain.ErrInvalidPageToken) {
+		t.Errorf("ListOrdersByCursor() garbage token error = %v, want %v", err, domain.ErrInvalidPageToken)
+	}
+	if _, _, _, err := svc.ListOrdersByCursor(ctx, 1, domain.OrderFilter{Sort: domain.OrderSortAmountDesc}, "", 2, false); !errors.Is(err, domain.ErrInvalidOrderFilter) {
+		t.Errorf("ListOrdersByCursor() amount sort error = %v, want %v", err, domain.ErrInvalidOrderFilter)
+	}
+}
+
 func TestOrderService_CancelOrder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -246,7 +386,7 @@ func TestOrderService_CancelOrder(t *testing.T) {
 		delete: func(ctx context.Context, prefix string) error { return nil },
 		ping:   func(ctx context.Context) error { return nil },
 	}
-	svc := NewOrderService(mockRepo, mockCache)
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
 
 	tests := []struct {
 		name          string
@@ -261,7 +401,13 @@ func TestOrderService_CancelOrder(t *testing.T) {
 			consignmentID: "DA251021BNWWN123",
 			userID:        1,
 			mockSetup: func() {
-				mockRepo.EXPECT().CancelOrder(gomock.Any(), "DA251021BNWWN123", int64(1)).Return(nil)
+				mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(pendingOrder(), nil)
+				mockRepo.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e *domain.OrderStatusEvent) error {
+					if e.FromStatus != domain.OrderStatusPending || e.ToStatus != domain.OrderStatusCancelled || e.ActorID != 1 {
+						t.Errorf("UpdateOrderStatus() event = %+v, want Pending to Cancelled by user 1", e)
+					}
+					return nil
+				})
 				mockCache.delete = func(ctx context.Context, prefix string) error { return nil }
 			},
 			wantErr: false,
@@ -271,18 +417,40 @@ func TestOrderService_CancelOrder(t *testing.T) {
 			consignmentID: "DA251021BNWWN123",
 			userID:        1,
 			mockSetup: func() {
-				mockRepo.EXPECT().CancelOrder(gomock.Any(), "DA251021BNWWN123", int64(1)).Return(errors.New("order not found"))
-				mockCache.delete = func(ctx context.Context, prefix string) error { return nil }
+				mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(nil, nil)
+			},
+			wantErr: true,
+			errMsg:  "order not found",
+		},
+		{
+			name:          "Order of another user",
+			consignmentID: "DA251021BNWWN123",
+			userID:        2,
+			mockSetup: func() {
+				mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(pendingOrder(), nil)
 			},
 			wantErr: true,
 			errMsg:  "order not found",
 		},
+		{
+			name:          "Order already picked up",
+			consignmentID: "DA251021BNWWN123",
+			userID:        1,
+			mockSetup: func() {
+				order := pendingOrder()
+				order.Status = domain.OrderStatusPickedUp
+				mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(order, nil)
+			},
+			wantErr: true,
+			errMsg:  "invalid order status transition: PickedUp to Cancelled",
+		},
 		{
 			name:          "Cache deletion error",
 			consignmentID: "DA251021BNWWN123",
 			userID:        1,
 			mockSetup: func() {
-				mockRepo.EXPECT().CancelOrder(gomock.Any(), "DA251021BNWWN123", int64(1)).Return(nil)
+				mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(pendingOrder(), nil)
+				mockRepo.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).Return(nil)
 				mockCache.delete = func(ctx context.Context, prefix string) error { return errors.New("cache error") }
 			},
 			wantErr: false, // Cache error doesn't fail the operation
@@ -304,4 +472,262 @@ func TestOrderService_CancelOrder(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func pendingOrder() *domain.Order {
+	return &domain.Order{ConsignmentID: "DA251021BNWWN123", Status: domain.OrderStatusPending, UserID: 1}
+}
+
+func TestOrderService_UpdateOrderStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	var invalidated string
+	mockCache := &mockCache{
+		delete: func(ctx context.Context, prefix string) error { invalidated = prefix; return nil },
+	}
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(pendingOrder(), nil)
+	mockRepo.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e *domain.OrderStatusEvent) error {
+		if e.ToStatus != domain.OrderStatusPickedUp || e.ActorID != 7 || e.Reason != "collected from merchant" {
+			t.Errorf("UpdateOrderStatus() event = %+v, want PickedUp by user 7 with the reason", e)
+		}
+		return nil
+	})
+	order, err := svc.UpdateOrderStatus(ctx, "DA251021BNWWN123", domain.OrderStatusPickedUp, " collected from merchant ", 7)
+	if err != nil || order.Status != domain.OrderStatusPickedUp {
+		t.Fatalf("UpdateOrderStatus() = %v, %v, want a picked up order", order, err)
+	}
+	if invalidated != "orders:user:1" {
+		t.Errorf("invalidated cache prefix = %q, want the owner's orders", invalidated)
+	}
+
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(pendingOrder(), nil)
+	if _, err := svc.UpdateOrderStatus(ctx, "DA251021BNWWN123", domain.OrderStatusDelivered, "", 7); !errors.Is(err, domain.ErrInvalidStatusTransition) {
+		t.Errorf("UpdateOrderStatus() skipping steps error = %v, want %v", err, domain.ErrInvalidStatusTransition)
+	}
+
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN404").Return(nil, nil)
+	if _, err := svc.UpdateOrderStatus(ctx, "DA251021BNWWN404", domain.OrderStatusPickedUp, "", 7); !errors.Is(err, domain.ErrOrderNotFound) {
+		t.Errorf("UpdateOrderStatus() unknown order error = %v, want %v", err, domain.ErrOrderNotFound)
+	}
+}
+
+func TestOrderService_GetOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, nil, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	history := []*domain.OrderStatusEvent{
+		{ConsignmentID: "DA251021BNWWN123", ToStatus: domain.OrderStatusPending, ActorID: 1, Reason: "order created"},
+		{ConsignmentID: "DA251021BNWWN123", FromStatus: domain.OrderStatusPending, ToStatus: domain.OrderStatusPickedUp, ActorID: 7},
+	}
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(pendingOrder(), nil).Times(2)
+	mockRepo.EXPECT().ListOrderStatusHistory(gomock.Any(), "DA251021BNWWN123").Return(history, nil)
+	order, got, err := svc.GetOrder(ctx, "DA251021BNWWN123", 1)
+	if err != nil || order.ConsignmentID != "DA251021BNWWN123" || len(got) != 2 {
+		t.Fatalf("GetOrder() = %v, %v, %v, want the order and its history", order, got, err)
+	}
+
+	// Orders of other users look like unknown orders
+	if _, _, err := svc.GetOrder(ctx, "DA251021BNWWN123", 2); !errors.Is(err, domain.ErrOrderNotFound) {
+		t.Errorf("GetOrder() other user's order error = %v, want %v", err, domain.ErrOrderNotFound)
+	}
+
+	// A mistyped ID is rejected without a lookup
+	id := domain.NewConsignmentID("DA251021", 42)
+	typo := id[:len(id)-2] + "9" + id[len(id)-1:]
+	if _, _, err := svc.GetOrder(ctx, typo, 1); !errors.Is(err, domain.ErrInvalidConsignmentID) {
+		t.Errorf("GetOrder(%q) error = %v, want %v", typo, err, domain.ErrInvalidConsignmentID)
+	}
+}
+
+func TestOrderService_QuoteDeliveryFee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, &mockCache{delete: func(ctx context.Context, prefix string) error { return nil }}, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	defaultStore(mockRepo)
+	ctx := context.Background()
+
+	order := bulkTestOrder("01712345678")
+	order.ItemWeight = 2.5
+	quote, err := svc.QuoteDeliveryFee(ctx, order, 1)
+	if err != nil {
+		t.Fatalf("QuoteDeliveryFee() error: %v", err)
+	}
+	if quote.BaseFee != 6000 || quote.WeightSurcharge != 3250 || quote.CODFee != 1000 || quote.TotalFee != 10250 {
+		t.Errorf("QuoteDeliveryFee() = %+v, want base 60.00, surcharge 32.50, cod 10.00, total 102.50", quote)
+	}
+	if order.TotalFee != 0 || order.ConsignmentID != "" {
+		t.Errorf("QuoteDeliveryFee() changed the order: %+v", order)
+	}
+
+	// The booked order is charged what was quoted
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+	created, err := svc.CreateOrder(ctx, order, 1)
+	if err != nil {
+		t.Fatalf("CreateOrder() error: %v", err)
+	}
+	if created.DeliveryFee != quote.DeliveryFee || created.CODFee != quote.CODFee || created.TotalFee != quote.TotalFee || created.RateCardVersion != quote.RateCardVersion {
+		t.Errorf("CreateOrder() fees = %v/%v/%v, want the quote %+v", created.DeliveryFee, created.CODFee, created.TotalFee, quote)
+	}
+
+	if _, err := svc.QuoteDeliveryFee(ctx, &domain.Order{RecipientCity: 1}, 1); err == nil {
+		t.Errorf("QuoteDeliveryFee() without weight error = nil, want error")
+	}
+}
+
+func TestOrderService_CreateOrderStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, &mockCache{delete: func(ctx context.Context, prefix string) error { return nil }}, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	store := &domain.Store{ID: 3, UserID: 1, Name: "Shop", ContactPhone: "01812345678", PickupAddress: "Banani, Dhaka", DefaultDeliveryType: 48}
+	mockRepo.EXPECT().FindStore(gomock.Any(), int64(3)).Return(store, nil).AnyTimes()
+	mockRepo.EXPECT().FindStore(gomock.Any(), int64(4)).Return(nil, nil).AnyTimes()
+
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+	order := bulkTestOrder("01712345678")
+	order.StoreID = 3
+	created, err := svc.CreateOrder(ctx, order, 1)
+	if err != nil {
+		t.Fatalf("CreateOrder() error: %v", err)
+	}
+	if created.StoreName != "Shop" || created.StoreContactPhone != "01812345678" || created.DeliveryType != 48 {
+		t.Errorf("CreateOrder() = %+v, want the details and delivery type of store 3", created)
+	}
+	if created.CreatedAt.Location() != time.UTC {
+		t.Errorf("CreateOrder() created at %v, want UTC", created.CreatedAt)
+	}
+
+	// Stores of other merchants and unknown stores are rejected alike
+	for _, tt := range []struct{ storeID, userID int64 }{{3, 2}, {4, 1}} {
+		order := bulkTestOrder("01712345678")
+		order.StoreID = tt.storeID
+		if _, err := svc.CreateOrder(ctx, order, tt.userID); !errors.Is(err, domain.ErrStoreNotFound) {
+			t.Errorf("CreateOrder() for store %d of user %d error = %v, want %v", tt.storeID, tt.userID, err, domain.ErrStoreNotFound)
+		}
+		if _, err := svc.QuoteDeliveryFee(ctx, order, tt.userID); !errors.Is(err, domain.ErrStoreNotFound) {
+			t.Errorf("QuoteDeliveryFee() for store %d of user %d error = %v, want %v", tt.storeID, tt.userID, err, domain.ErrStoreNotFound)
+		}
+	}
+
+	// Without a store ID the merchant's oldest store is used. Merchants
+	// without stores book orders without store details.
+	mockRepo.EXPECT().ListStores(gomock.Any(), int64(1)).Return([]*domain.Store{store}, nil)
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+	if created, err := svc.CreateOrder(ctx, bulkTestOrder("01712345678"), 1); err != nil || created.StoreID != 3 {
+		t.Errorf("CreateOrder() without a store = %+v, %v, want store 3", created, err)
+	}
+	mockRepo.EXPECT().ListStores(gomock.Any(), int64(2)).Return(nil, nil).Times(2)
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+	created, err = svc.CreateOrder(ctx, bulkTestOrder("01712345678"), 2)
+	if err != nil || created.StoreID != 0 || created.StoreName != "" || created.StoreContactPhone != "" {
+		t.Errorf("CreateOrder() of a merchant without stores = %+v, %v, want no store details", created, err)
+	}
+	if _, err := svc.QuoteDeliveryFee(ctx, bulkTestOrder("01712345678"), 2); err != nil {
+		t.Errorf("QuoteDeliveryFee() of a merchant without stores error: %v", err)
+	}
+}
+
+func TestOrderService_CreateOrderDestination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewOrderService(mockRepo, nil, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	defaultStore(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+	order := bulkTestOrder("01712345678")
+	order.RecipientZone, order.RecipientArea = 1, 1
+	if _, err := svc.CreateOrder(ctx, order, 1); err != nil {
+		t.Fatalf("CreateOrder() error: %v", err)
+	}
+
+	// Zone 1 is in city 1
+	order = bulkTestOrder("01712345678")
+	order.RecipientCity, order.RecipientZone = 2, 1
+	if _, err := svc.CreateOrder(ctx, order, 1); !errors.Is(err, domain.ErrInvalidLocation) {
+		t.Errorf("CreateOrder() with a zone of another city error = %v, want %v", err, domain.ErrInvalidLocation)
+	}
+	if _, err := svc.QuoteDeliveryFee(ctx, order, 1); !errors.Is(err, domain.ErrInvalidLocation) {
+		t.Errorf("QuoteDeliveryFee() with a zone of another city error = %v, want %v", err, domain.ErrInvalidLocation)
+	}
+}
+
+func TestOrderService_UpdateOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	var invalidated string
+	mockCache := &mockCache{
+		delete: func(ctx context.Context, prefix string) error { invalidated = prefix; return nil },
+	}
+	svc := NewOrderService(mockRepo, mockCache, &sequenceIDs{}, defaultPricing(mockRepo), defaultLocations(mockRepo))
+	ctx := context.Background()
+
+	booked := func(status string) *domain.Order {
+		o := bulkTestOrder("01712345678")
+		o.ConsignmentID, o.Status, o.UserID = "DA251021BNWWN123", status, 1
+		o.DeliveryFee, o.DeliveryCharge, o.CODFee, o.TotalFee = 6000, 6000, 1000, 7000
+		o.OrderAmount, o.CODAmount = o.AmountToCollect, o.AmountToCollect
+		return o
+	}
+	edit := bulkTestOrder("01812345678")
+	edit.ItemWeight = 2.5
+
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(booked(domain.OrderStatusPending), nil)
+	mockRepo.EXPECT().UpdateOrder(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o *domain.Order, e *domain.OrderStatusEvent) error {
+		var fields []string
+		for _, c := range e.Changes {
+			fields = append(fields, c.Field)
+		}
+		want := []string{"recipient_phone", "item_weight", "delivery_fee", "total_fee"}
+		if !reflect.DeepEqual(fields, want) || e.FromStatus != domain.OrderStatusPending || e.ToStatus != domain.OrderStatusPending || e.ActorID != 1 {
+			t.Errorf("UpdateOrder() event = %+v, want changes of %v by user 1", e, want)
+		}
+		return nil
+	})
+	updated, err := svc.UpdateOrder(ctx, "DA251021BNWWN123", edit, 1)
+	if err != nil {
+		t.Fatalf("UpdateOrder() error: %v", err)
+	}
+	if updated.RecipientPhone != "01812345678" || updated.DeliveryFee != 9250 || updated.TotalFee != 10250 || updated.ConsignmentID != "DA251021BNWWN123" {
+		t.Errorf("UpdateOrder() = %+v, want the new phone and a delivery fee of 92.50", updated)
+	}
+	if invalidated != "orders:user:1" {
+		t.Errorf("invalidated cache prefix = %q, want the owner's orders", invalidated)
+	}
+
+	// An edit that changes nothing is not stored
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(booked(domain.OrderStatusPending), nil)
+	if _, err := svc.UpdateOrder(ctx, "DA251021BNWWN123", bulkTestOrder("01712345678"), 1); err != nil {
+		t.Errorf("UpdateOrder() without changes error: %v", err)
+	}
+
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(booked(domain.OrderStatusPickedUp), nil)
+	if _, err := svc.UpdateOrder(ctx, "DA251021BNWWN123", edit, 1); !errors.Is(err, domain.ErrOrderNotEditable) {
+		t.Errorf("UpdateOrder() after pickup error = %v, want %v", err, domain.ErrOrderNotEditable)
+	}
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(booked(domain.OrderStatusPending), nil)
+	if _, err := svc.UpdateOrder(ctx, "DA251021BNWWN123", edit, 2); !errors.Is(err, domain.ErrOrderNotFound) {
+		t.Errorf("UpdateOrder() of another user's order error = %v, want %v", err, domain.ErrOrderNotFound)
+	}
+	mockRepo.EXPECT().FindOrder(gomock.Any(), "DA251021BNWWN123").Return(booked(domain.OrderStatusPending), nil)
+	if _, err := svc.UpdateOrder(ctx, "DA251021BNWWN123", bulkTestOrder("123"), 1); err == nil || err.Error() != "invalid phone number" {
+		t.Errorf("UpdateOrder() with a bad phone error = %v, want invalid phone number", err)
+	}
+}
@@ -0,0 +1,41 @@
+// internal/application/password_policy.go
+package application
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+const (
+	minPasswordLength = 8
+	// bcrypt ignores everything after 72 bytes.
+	maxPasswordLength = 72
+)
+
+// validatePassword enforces the password strength policy used by Signup,
+// ChangePassword and ResetPassword.
+func validatePassword(username, password string) error {
+	if len(password) < minPasswordLength {
+		return errors.New("password must be at least 8 characters long")
+	}
+	if len(password) > maxPasswordLength {
+		return errors.New("password must be at most 72 bytes long")
+	}
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return errors.New("password must contain at least one letter and one digit")
+	}
+	if username != "" && strings.EqualFold(password, username) {
+		return errors.New("password must not match the username")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// internal/application/password_policy_test.go
+package application
+
+import "testing"
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		password string
+		wantErr  bool
+	}{
+		{name: "Valid", username: "user@example.com", password: "securepass1"},
+		{name: "Too short", username: "user@example.com", password: "abc123", wantErr: true},
+		{name: "Too long", username: "user@example.com", password: "a1" + string(make([]byte, 71)), wantErr: true},
+		{name: "No digit", username: "user@example.com", password: "securepass", wantErr: true},
+		{name: "No letter", username: "user@example.com", password: "1234567890", wantErr: true},
+		{name: "Same as username", username: "user12345", password: "USER12345", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePassword(tt.username, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// internal/application/pricing_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+// rateCardRefresh is how long loaded rate cards are used before they are
+// loaded again, so a card created on another replica applies within that time.
+const rateCardRefresh = time.Minute
+
+type PricingService struct {
+	repo ports.OrderRepositoryPort
+
+	mu       sync.Mutex
+	cards    []*domain.RateCard
+	loadedAt time.Time
+}
+
+func NewPricingService(repo ports.OrderRepositoryPort) *PricingService {
+	return &PricingService{repo: repo}
+}
+
+// CreateRateCard stores a new version of the prices. It takes effect at
+// card.EffectiveFrom, or at once if that is zero.
+func (s *PricingService) CreateRateCard(ctx context.Context, card *domain.RateCard, createdBy int64) (*domain.RateCard, error) {
+	if err := card.Validate(); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if card.EffectiveFrom.IsZero() {
+		card.EffectiveFrom = now
+	}
+	card.CreatedBy = createdBy
+	card.CreatedAt = now
+	if err := s.repo.CreateRateCard(ctx, card); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cards = nil
+	s.mu.Unlock()
+	return card, nil
+}
+
+// ListRateCards returns every version of the prices, oldest first.
+func (s *PricingService) ListRateCards(ctx context.Context) ([]*domain.RateCard, error) {
+	return s.repo.ListRateCards(ctx)
+}
+
+// EnsureRateCard stores domain.DefaultRateCard if there is no rate card yet,
+// so that orders can be priced on a new database.
+func (s *PricingService) EnsureRateCard(ctx context.Context) error {
+	cards, err := s.repo.ListRateCards(ctx)
+	if err != nil || len(cards) > 0 {
+		return err
+	}
+	card := domain.DefaultRateCard()
+	// Effective since the beginning of time, so it also prices backdated quotes
+	card.EffectiveFrom = time.Unix(0, 0).UTC()
+	_, err = s.CreateRateCard(ctx, card, 0)
+	return err
+}
+
+// Price computes the fees of an order with the rate card in effect at t, less
+// the discount of the merchant and of the order's promo code, if any. The promo
+// code's usage caps are checked against the redemptions so far; they are
+// enforced again when the order is stored.
+func (s *PricingService) Price(ctx context.Context, order *domain.Order, t time.Time) (domain.Fees, error) {
+	return s.price(ctx, order, t, false)
+}
+
+// Reprice computes the fees of an edited order like Price. The order already
+// holds a redemption of its promo code, so the code's usage caps are not
+// checked again and its validity window is checked at the creation of the
+// order.
+func (s *PricingService) Reprice(ctx context.Context, order *domain.Order, t time.Time) (domain.Fees, error) {
+	return s.price(ctx, order, t, true)
+}
+
+func (s *PricingService) price(ctx context.Context, order *domain.Order, t time.Time, redeemed bool) (domain.Fees, error) {
+	cards, err := s.rateCards(ctx)
+	if err != nil {
+		return domain.Fees{}, err
+	}
+	card := domain.CurrentRateCard(cards, t)
+	if card == nil {
+		return domain.Fees{}, fmt.Errorf("%w: no rate card in effect", domain.ErrNoRate)
+	}
+	fees, err := card.Price(order)
+	if err != nil {
+		return domain.Fees{}, err
+	}
+
+	var merchantPercent float64
+	if order.UserID != 0 {
+		user, err := s.repo.FindUserByID(ctx, order.UserID)
+		if err != nil {
+			return domain.Fees{}, err
+		}
+		if user != nil {
+			merchantPercent = user.DiscountPercent
+		}
+	}
+	promo, err := s.promotion(ctx, order, t, redeemed)
+	if err != nil {
+		return domain.Fees{}, err
+	}
+	fees.ApplyDiscounts(merchantPercent, promo)
+	return fees, nil
+}
+
+// promotion returns the promotion of the order's promo code, or nil if it has
+// none.
+func (s *PricingService) promotion(ctx context.Context, order *domain.Order, t time.Time, redeemed bool) (*domain.Promotion, error) {
+	if order.PromoCode == "" {
+		return nil, nil
+	}
+	promo, err := s.repo.FindPromotionByCode(ctx, order.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	if promo == nil {
+		return nil, domain.ErrInvalidPromoCode
+	}
+	if redeemed {
+		return promo, promo.CheckEligible(order, order.CreatedAt)
+	}
+	if err := promo.CheckEligible(order, t); err != nil {
+		return nil, err
+	}
+	if promo.MaxRedemptions > 0 && promo.Redemptions >= promo.MaxRedemptions {
+		return nil, domain.ErrPromoCodeExhausted
+	}
+	if promo.MaxPerMerchant > 0 {
+		used, err := s.repo.CountPromotionRedemptions(ctx, promo.ID, order.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if used >= promo.MaxPerMerchant {
+			return nil, domain.ErrPromoCodeExhausted
+		}
+	}
+	return promo, nil
+}
+
+// CreatePromotion stores a new promo code. It is valid from
+// promo.StartsAt, or at once if that is zero.
+func (s *PricingService) CreatePromotion(ctx context.Context, promo *domain.Promotion, createdBy int64) (*domain.Promotion, error) {
+	now := time.Now().UTC()
+	if promo.StartsAt.IsZero() {
+		promo.StartsAt = now
+	}
+	if err := promo.Validate(); err != nil {
+		return nil, err
+	}
+	promo.Redemptions = 0
+	promo.CreatedBy = createdBy
+	promo.CreatedAt = now
+	if err := s.repo.CreatePromotion(ctx, promo); err != nil {
+		return nil, err
+	}
+	return promo, nil
+}
+
+// ListPromotions returns every promo code, newest first.
+func (s *PricingService) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+	return s.repo.ListPromotions(ctx)
+}
+
+// SetMerchantDiscount sets the percentage taken off the delivery fee of every
+// new order of a merchant. Zero removes the discount.
+func (s *PricingService) SetMerchantDiscount(ctx context.Context, userID int64, percent float64) error {
+	if percent < 0 || percent > 100 {
+		return errors.New("discount percent must be between 0 and 100")
+	}
+	return s.repo.UpdateUserDiscount(ctx, userID, percent)
+}
+
+func (s *PricingService) rateCards(ctx context.Context) ([]*domain.RateCard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cards != nil && time.Since(s.loadedAt) < rateCardRefresh {
+		return s.cards, nil
+	}
+	cards, err := s.repo.ListRateCards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.cards, s.loadedAt = cards, time.Now()
+	return cards, nil
+}
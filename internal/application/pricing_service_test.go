@@ -0,0 +1,172 @@
+// internal/application/pricing_service_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+func TestPricingService_Price(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+	now := time.Now()
+
+	current := &domain.RateCard{Version: 1, EffectiveFrom: now.Add(-time.Hour), Rates: []domain.Rate{{BaseFee: 6000}}}
+	next := &domain.RateCard{Version: 2, EffectiveFrom: now.Add(time.Hour), Rates: []domain.Rate{{BaseFee: 6500}}}
+	// Loaded cards are reused until a new card is created
+	mockRepo.EXPECT().ListRateCards(gomock.Any()).Return([]*domain.RateCard{current, next}, nil)
+	order := &domain.Order{ItemWeight: 0.5}
+	for _, at := range []time.Time{now, now.Add(2 * time.Hour)} {
+		fees, err := svc.Price(ctx, order, at)
+		if err != nil {
+			t.Fatalf("Price() error: %v", err)
+		}
+		want := current
+		if at.After(next.EffectiveFrom) {
+			want = next
+		}
+		if fees.RateCardVersion != want.Version || fees.DeliveryFee != want.Rates[0].BaseFee {
+			t.Errorf("Price() at %v = %+v, want version %d", at, fees, want.Version)
+		}
+	}
+	if _, err := svc.Price(ctx, order, now.Add(-2*time.Hour)); !errors.Is(err, domain.ErrNoRate) {
+		t.Errorf("Price() before the first card error = %v, want %v", err, domain.ErrNoRate)
+	}
+
+	if _, err := svc.CreateRateCard(ctx, &domain.RateCard{}, 1); !errors.Is(err, domain.ErrInvalidRateCard) {
+		t.Fatalf("CreateRateCard() without rates error = %v, want %v", err, domain.ErrInvalidRateCard)
+	}
+	mockRepo.EXPECT().CreateRateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card *domain.RateCard) error {
+		card.Version = 3
+		return nil
+	})
+	card, err := svc.CreateRateCard(ctx, &domain.RateCard{Rates: []domain.Rate{{BaseFee: 7000}}}, 1)
+	if err != nil || card.Version != 3 || card.EffectiveFrom.IsZero() || card.CreatedBy != 1 {
+		t.Fatalf("CreateRateCard() = %+v, %v, want version 3 in effect now", card, err)
+	}
+	mockRepo.EXPECT().ListRateCards(gomock.Any()).Return([]*domain.RateCard{current, next, card}, nil)
+	if fees, err := svc.Price(ctx, order, time.Now()); err != nil || fees.RateCardVersion != 3 {
+		t.Errorf("Price() after CreateRateCard() = %+v, %v, want version 3", fees, err)
+	}
+}
+
+func TestPricingService_EnsureRateCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().ListRateCards(gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().CreateRateCard(gomock.Any(), gomock.Any()).Return(nil)
+	if err := svc.EnsureRateCard(ctx); err != nil {
+		t.Fatalf("EnsureRateCard() error: %v", err)
+	}
+
+	// An existing card is left alone
+	mockRepo.EXPECT().ListRateCards(gomock.Any()).Return([]*domain.RateCard{domain.DefaultRateCard()}, nil)
+	if err := svc.EnsureRateCard(ctx); err != nil {
+		t.Fatalf("EnsureRateCard() error: %v", err)
+	}
+}
+
+func TestPricingService_PriceDiscounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+	now := time.Now()
+
+	mockRepo.EXPECT().ListRateCards(gomock.Any()).Return([]*domain.RateCard{domain.DefaultRateCard()}, nil).AnyTimes()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(7)).Return(&domain.User{ID: 7, DiscountPercent: 10}, nil).AnyTimes()
+	promo := &domain.Promotion{ID: 3, Code: "EID", Kind: domain.PromotionFlat, Amount: 2000, StartsAt: now.Add(-time.Hour), MaxPerMerchant: 2}
+	mockRepo.EXPECT().FindPromotionByCode(gomock.Any(), "EID").Return(promo, nil).AnyTimes()
+	mockRepo.EXPECT().FindPromotionByCode(gomock.Any(), "NOPE").Return(nil, nil)
+
+	// 100 less 10% for the merchant and 20 for the promo code
+	order := &domain.Order{UserID: 7, RecipientCity: 2, ItemWeight: 0.5, PromoCode: "EID"}
+	mockRepo.EXPECT().CountPromotionRedemptions(gomock.Any(), int64(3), int64(7)).Return(int64(1), nil)
+	fees, err := svc.Price(ctx, order, now)
+	if err != nil || fees.Discount != 1000 || fees.PromoDiscount != 2000 || fees.TotalFee != 7000 {
+		t.Fatalf("Price() = %+v, %v, want discounts of 10 and 20", fees, err)
+	}
+
+	mockRepo.EXPECT().CountPromotionRedemptions(gomock.Any(), int64(3), int64(7)).Return(int64(2), nil)
+	if _, err := svc.Price(ctx, order, now); !errors.Is(err, domain.ErrPromoCodeExhausted) {
+		t.Errorf("Price() over the merchant cap error = %v, want %v", err, domain.ErrPromoCodeExhausted)
+	}
+	order.PromoCode = "NOPE"
+	if _, err := svc.Price(ctx, order, now); !errors.Is(err, domain.ErrInvalidPromoCode) {
+		t.Errorf("Price() with an unknown code error = %v, want %v", err, domain.ErrInvalidPromoCode)
+	}
+}
+
+func TestPricingService_CreatePromotion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+
+	if _, err := svc.CreatePromotion(ctx, &domain.Promotion{Code: "x"}, 1); !errors.Is(err, domain.ErrInvalidPromotion) {
+		t.Fatalf("CreatePromotion() error = %v, want %v", err, domain.ErrInvalidPromotion)
+	}
+	mockRepo.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).Return(nil)
+	promo, err := svc.CreatePromotion(ctx, &domain.Promotion{Code: "eid", Kind: domain.PromotionPercent, Value: 10, Redemptions: 5}, 1)
+	if err != nil || promo.Code != "EID" || promo.StartsAt.IsZero() || promo.Redemptions != 0 || promo.CreatedBy != 1 {
+		t.Fatalf("CreatePromotion() = %+v, %v, want code EID valid from now", promo, err)
+	}
+
+	if err := svc.SetMerchantDiscount(ctx, 7, 120); err == nil {
+		t.Error("SetMerchantDiscount() above 100% succeeded")
+	}
+	mockRepo.EXPECT().UpdateUserDiscount(gomock.Any(), int64(7), 15.0).Return(nil)
+	if err := svc.SetMerchantDiscount(ctx, 7, 15); err != nil {
+		t.Errorf("SetMerchantDiscount() error: %v", err)
+	}
+}
+
+func TestPricingService_Reprice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewPricingService(mockRepo)
+	ctx := context.Background()
+	now := time.Now()
+
+	mockRepo.EXPECT().ListRateCards(gomock.Any()).Return([]*domain.RateCard{domain.DefaultRateCard()}, nil).AnyTimes()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(7)).Return(nil, nil).AnyTimes()
+	// The code has expired and is used up since the order was booked with it
+	ended := now.Add(-time.Hour)
+	promo := &domain.Promotion{ID: 3, Code: "EID", Kind: domain.PromotionFlat, Amount: 2000, StartsAt: now.Add(-48 * time.Hour), EndsAt: &ended, MaxRedemptions: 1, Redemptions: 1, Cities: []int64{2}}
+	mockRepo.EXPECT().FindPromotionByCode(gomock.Any(), "EID").Return(promo, nil).AnyTimes()
+
+	order := &domain.Order{UserID: 7, RecipientCity: 2, ItemWeight: 0.5, PromoCode: "EID", CreatedAt: now.Add(-24 * time.Hour)}
+	fees, err := svc.Reprice(ctx, order, now)
+	if err != nil || fees.PromoDiscount != 2000 {
+		t.Fatalf("Reprice() = %+v, %v, want the promo discount kept", fees, err)
+	}
+	if _, err := svc.Price(ctx, order, now); !errors.Is(err, domain.ErrInvalidPromoCode) {
+		t.Errorf("Price() with an expired code error = %v, want %v", err, domain.ErrInvalidPromoCode)
+	}
+	// Edits still have to meet the code's conditions
+	order.RecipientCity = 1
+	if _, err := svc.Reprice(ctx, order, now); !errors.Is(err, domain.ErrPromoNotApplicable) {
+		t.Errorf("Reprice() to another city error = %v, want %v", err, domain.ErrPromoNotApplicable)
+	}
+}
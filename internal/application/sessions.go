@@ -0,0 +1,67 @@
+// internal/application/sessions.go
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+// Client supplied session details are cut to these lengths before storing.
+const (
+	maxDeviceLength    = 255
+	maxUserAgentLength = 512
+)
+
+func (s *AuthService) createSession(ctx context.Context, userID int64, id string, client domain.ClientInfo) error {
+	now := time.Now().UTC()
+	return s.repo.CreateSession(ctx, &domain.Session{
+		ID:         id,
+		UserID:     userID,
+		Device:     truncate(client.Device, maxDeviceLength),
+		IP:         client.IP,
+		UserAgent:  truncate(client.UserAgent, maxUserAgentLength),
+		CreatedAt:  now,
+		LastSeenAt: now,
+	})
+}
+
+// ListSessions returns the active sessions of a user. LastSeenAt is updated
+// when the session refreshes its tokens, so it lags by up to one access token
+// lifetime.
+func (s *AuthService) ListSessions(ctx context.Context, userID int64) ([]*domain.Session, error) {
+	return s.repo.ListSessions(ctx, userID)
+}
+
+// RevokeSession signs a session of userID out: its refresh tokens stop
+// working and its access tokens are rejected from now on.
+func (s *AuthService) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	if sessionID == "" {
+		return errors.New("session id is required")
+	}
+	if err := s.repo.RevokeSession(ctx, sessionID, userID); err != nil {
+		return err
+	}
+	if err := s.repo.RevokeRefreshTokenFamily(ctx, sessionID); err != nil {
+		return err
+	}
+	// Access tokens issued for the session expire within AccessTokenTTL
+	return s.revocations.Revoke(ctx, sessionRevocationID(sessionID), time.Now().UTC().Add(auth.AccessTokenTTL))
+}
+
+// sessionRevocationID keeps session IDs apart from token IDs in the
+// revocation store.
+func sessionRevocationID(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,74 @@
+// internal/application/sessions_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/revocation"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+func TestAuthService_RevokeSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewAuthService(mockRepo, revocation.NewMemoryStore(), nil, nil, DefaultLockoutConfig())
+
+	ctx := context.Background()
+	phoneToken, _ := auth.GenerateToken("testuser@example.com", 1, []string{"merchant"}, "phone")
+	laptopToken, _ := auth.GenerateToken("testuser@example.com", 1, []string{"merchant"}, "laptop")
+
+	// Another user cannot revoke the session
+	mockRepo.EXPECT().RevokeSession(gomock.Any(), "phone", int64(2)).Return(domain.ErrSessionNotFound)
+	if err := svc.RevokeSession(ctx, 2, "phone"); !errors.Is(err, domain.ErrSessionNotFound) {
+		t.Fatalf("RevokeSession() other user error = %v, want %v", err, domain.ErrSessionNotFound)
+	}
+	if _, err := svc.Authenticate(ctx, phoneToken); err != nil {
+		t.Fatalf("Authenticate() before revocation error: %v", err)
+	}
+
+	mockRepo.EXPECT().RevokeSession(gomock.Any(), "phone", int64(1)).Return(nil)
+	mockRepo.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), "phone").Return(nil)
+	if err := svc.RevokeSession(ctx, 1, "phone"); err != nil {
+		t.Fatalf("RevokeSession() error: %v", err)
+	}
+	if _, err := svc.Authenticate(ctx, phoneToken); !errors.Is(err, domain.ErrTokenRevoked) {
+		t.Errorf("Authenticate() revoked session error = %v, want %v", err, domain.ErrTokenRevoked)
+	}
+	if _, err := svc.Authenticate(ctx, laptopToken); err != nil {
+		t.Errorf("Authenticate() other session error: %v", err)
+	}
+}
+
+func TestAuthService_LogoutEndsSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	mockRevocations := ports.NewMockRevocationStorePort(ctrl)
+	svc := NewAuthService(mockRepo, mockRevocations, nil, nil, DefaultLockoutConfig())
+
+	token, _ := auth.GenerateToken("testuser@example.com", 1, []string{"merchant"}, "phone")
+	claims, _ := auth.ValidateToken(token)
+
+	mockRevocations.EXPECT().Revoke(gomock.Any(), claims.ID, claims.ExpiresAt.Time).Return(nil)
+	mockRepo.EXPECT().RevokeSession(gomock.Any(), "phone", int64(1)).Return(nil)
+	mockRepo.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), "phone").Return(nil)
+	mockRevocations.EXPECT().Revoke(gomock.Any(), sessionRevocationID("phone"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, until time.Time) error {
+			if until.Before(claims.ExpiresAt.Time) {
+				t.Errorf("Revoke() session until = %v, want at least %v", until, claims.ExpiresAt.Time)
+			}
+			return nil
+		})
+	if err := svc.Logout(context.WithValue(context.Background(), "token", token), 1); err != nil {
+		t.Fatalf("Logout() error: %v", err)
+	}
+}
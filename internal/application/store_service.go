@@ -0,0 +1,100 @@
+// internal/application/store_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+type StoreService struct {
+	repo ports.OrderRepositoryPort
+}
+
+func NewStoreService(repo ports.OrderRepositoryPort) *StoreService {
+	return &StoreService{repo: repo}
+}
+
+// CreateStore adds a store for the user.
+func (s *StoreService) CreateStore(ctx context.Context, store *domain.Store, userID int64) (*domain.Store, error) {
+	if err := validateStore(store); err != nil {
+		return nil, err
+	}
+	store.ID = 0
+	store.UserID = userID
+	store.CreatedAt = time.Now().UTC()
+	store.UpdatedAt = store.CreatedAt
+	if err := s.repo.CreateStore(ctx, store); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// UpdateStore replaces the details of one of the user's stores. Orders
+// already booked keep the store name and phone they were created with.
+func (s *StoreService) UpdateStore(ctx context.Context, store *domain.Store, userID int64) (*domain.Store, error) {
+	if err := validateStore(store); err != nil {
+		return nil, err
+	}
+	store.UserID = userID
+	store.UpdatedAt = time.Now().UTC()
+	if err := s.repo.UpdateStore(ctx, store); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// ListStores returns the user's stores, oldest first.
+func (s *StoreService) ListStores(ctx context.Context, userID int64) ([]*domain.Store, error) {
+	return s.repo.ListStores(ctx, userID)
+}
+
+// validateStore trims the fields a merchant enters for a store and checks
+// them.
+func validateStore(store *domain.Store) error {
+	store.Name = strings.TrimSpace(store.Name)
+	store.ContactPhone = strings.TrimSpace(store.ContactPhone)
+	store.PickupAddress = strings.TrimSpace(store.PickupAddress)
+	if store.Name == "" || len(store.Name) > 255 {
+		return fmt.Errorf("%w: name is required and at most 255 characters", domain.ErrInvalidStore)
+	}
+	if !phoneRegex.MatchString(store.ContactPhone) {
+		return fmt.Errorf("%w: invalid contact phone", domain.ErrInvalidStore)
+	}
+	if store.PickupAddress == "" {
+		return fmt.Errorf("%w: pickup address is required", domain.ErrInvalidStore)
+	}
+	if store.DefaultDeliveryType < 0 {
+		return fmt.Errorf("%w: default delivery type must not be negative", domain.ErrInvalidStore)
+	}
+	return nil
+}
+
+// orderStore returns the store of userID an order is booked for: the store
+// with storeID, or the user's oldest store if storeID is 0. A user without
+// stores gets an empty store, so their orders have no store details. Stores
+// of other users are reported as not found.
+func orderStore(ctx context.Context, repo ports.OrderRepositoryPort, storeID, userID int64) (*domain.Store, error) {
+	if storeID == 0 {
+		stores, err := repo.ListStores(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if len(stores) == 0 {
+			return &domain.Store{}, nil
+		}
+		return stores[0], nil
+	}
+	store, err := repo.FindStore(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil || store.UserID != userID {
+		return nil, domain.ErrStoreNotFound
+	}
+	return store, nil
+}
@@ -0,0 +1,65 @@
+// internal/application/store_service_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+)
+
+func TestStoreService_CreateStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewStoreService(mockRepo)
+	ctx := context.Background()
+
+	invalid := []*domain.Store{
+		{ContactPhone: "01812345678", PickupAddress: "Banani, Dhaka"},
+		{Name: "Shop", ContactPhone: "123456789", PickupAddress: "Banani, Dhaka"},
+		{Name: "Shop", ContactPhone: "01812345678", PickupAddress: "  "},
+		{Name: "Shop", ContactPhone: "01812345678", PickupAddress: "Banani, Dhaka", DefaultDeliveryType: -1},
+	}
+	for i, st := range invalid {
+		if _, err := svc.CreateStore(ctx, st, 1); !errors.Is(err, domain.ErrInvalidStore) {
+			t.Errorf("store %d: CreateStore() error = %v, want %v", i, err, domain.ErrInvalidStore)
+		}
+	}
+
+	mockRepo.EXPECT().CreateStore(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, st *domain.Store) error {
+		st.ID = 3
+		return nil
+	})
+	store, err := svc.CreateStore(ctx, &domain.Store{ID: 9, UserID: 2, Name: " Shop ", ContactPhone: "01812345678", PickupAddress: "Banani, Dhaka"}, 1)
+	if err != nil || store.ID != 3 || store.UserID != 1 || store.Name != "Shop" || store.CreatedAt.IsZero() {
+		t.Fatalf("CreateStore() = %+v, %v, want store 3 of user 1", store, err)
+	}
+}
+
+func TestStoreService_UpdateStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewStoreService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().UpdateStore(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, st *domain.Store) error {
+		if st.UserID != 1 {
+			return domain.ErrStoreNotFound
+		}
+		return nil
+	}).Times(2)
+	store := &domain.Store{ID: 3, Name: "Shop", ContactPhone: "01812345678", PickupAddress: "Gulshan, Dhaka", DefaultDeliveryType: 48}
+	if updated, err := svc.UpdateStore(ctx, store, 1); err != nil || updated.UpdatedAt.IsZero() {
+		t.Fatalf("UpdateStore() = %+v, %v, want the store updated", updated, err)
+	}
+	if _, err := svc.UpdateStore(ctx, store, 2); !errors.Is(err, domain.ErrStoreNotFound) {
+		t.Errorf("UpdateStore() of another user's store error = %v, want %v", err, domain.ErrStoreNotFound)
+	}
+}
@@ -0,0 +1,232 @@
+// internal/application/two_factor.go
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+)
+
+const (
+	recoveryCodeCount = 10
+	// maxTwoFactorFailures is how many wrong codes a challenge token, or the
+	// access token of a logged-in user, may be used for before it is revoked.
+	maxTwoFactorFailures = 5
+)
+
+// TOTPIssuer names the service in authenticator apps.
+var TOTPIssuer = "grpc-ecommerce"
+
+// EnrollTwoFactor starts TOTP enrollment and returns the secret together with
+// its provisioning URI. Two-factor authentication stays off until the user
+// confirms the enrollment with ConfirmTwoFactor; enrolling again before that
+// replaces the secret.
+func (s *AuthService) EnrollTwoFactor(ctx context.Context, userID int64) (secret, uri string, err error) {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if user == nil {
+		return "", "", errors.New("user not found")
+	}
+	tf, err := s.repo.FindTwoFactor(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if tf.Enabled() {
+		return "", "", domain.ErrTwoFactorEnabled
+	}
+	secret, err = auth.GenerateTOTPSecret()
+	if err != nil {
+		return "", "", err
+	}
+	err = s.repo.SaveTwoFactor(ctx, &domain.TwoFactor{UserID: userID, Secret: secret, CreatedAt: time.Now().UTC()})
+	if err != nil {
+		return "", "", err
+	}
+	return secret, auth.TOTPProvisioningURI(TOTPIssuer, user.Username, secret), nil
+}
+
+// ConfirmTwoFactor enables two-factor authentication once the user proves
+// their authenticator works, and returns the recovery codes. They are shown
+// only this once.
+func (s *AuthService) ConfirmTwoFactor(ctx context.Context, userID int64, code string) ([]string, error) {
+	tf, err := s.repo.FindTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, errors.New("two-factor enrollment not started")
+	}
+	if tf.Enabled() {
+		return nil, domain.ErrTwoFactorEnabled
+	}
+	step, ok := auth.ValidateTOTP(tf.Secret, strings.TrimSpace(code), time.Now())
+	if !ok {
+		return nil, domain.ErrInvalidTwoFactor
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.EnableTwoFactor(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyTwoFactor completes a login that Login answered with a challenge
+// token. code is a TOTP code or one of the recovery codes. Wrong codes count
+// as failed logins, and too many of them invalidate the challenge.
+func (s *AuthService) VerifyTwoFactor(ctx context.Context, challengeToken, code string, client domain.ClientInfo) (*domain.TokenPair, error) {
+	claims, err := auth.ValidateChallengeToken(challengeToken)
+	if err != nil || claims.ID == "" {
+		return nil, domain.ErrInvalidChallenge
+	}
+	if err := s.throttle.check(ctx, claims.Username, client.IP); err != nil {
+		return nil, err
+	}
+	// Challenge tokens are single use
+	revoked, err := s.revocations.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, domain.ErrInvalidChallenge
+	}
+	user, err := s.repo.FindUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrInvalidChallenge
+	}
+	tf, err := s.repo.FindTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !tf.Enabled() {
+		return nil, domain.ErrInvalidChallenge
+	}
+	if err := s.checkSecondFactor(ctx, tf, code); err != nil {
+		if errors.Is(err, domain.ErrInvalidTwoFactor) {
+			s.throttle.failure(ctx, claims.Username, client.IP)
+			if err := s.twoFactorFailure(ctx, claims); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	if err := s.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return nil, err
+	}
+	s.throttle.success(ctx, claims.Username)
+	return s.startSession(ctx, user, client)
+}
+
+// DisableTwoFactor turns two-factor authentication off after checking a
+// current TOTP or recovery code.
+func (s *AuthService) DisableTwoFactor(ctx context.Context, userID int64, code string) error {
+	if err := s.requireSecondFactor(ctx, userID, code); err != nil {
+		return err
+	}
+	return s.repo.DeleteTwoFactor(ctx, userID)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of the user, used or
+// not, after checking a current TOTP or recovery code.
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, userID int64, code string) ([]string, error) {
+	if err := s.requireSecondFactor(ctx, userID, code); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// requireSecondFactor checks code for a logged-in user with two-factor
+// authentication enabled. Wrong codes count against the username's login
+// throttle and the access token of the call, which is revoked after too many,
+// so a stolen access token cannot be used to guess them.
+func (s *AuthService) requireSecondFactor(ctx context.Context, userID int64, code string) error {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+	tf, err := s.repo.FindTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !tf.Enabled() {
+		return domain.ErrTwoFactorDisabled
+	}
+	if err := s.throttle.check(ctx, user.Username, ""); err != nil {
+		return err
+	}
+	err = s.checkSecondFactor(ctx, tf, code)
+	if errors.Is(err, domain.ErrInvalidTwoFactor) {
+		s.throttle.failure(ctx, user.Username, "")
+		if token, ok := ctx.Value("token").(string); ok {
+			if claims, cerr := auth.ValidateToken(token); cerr == nil && claims.ID != "" {
+				if cerr := s.twoFactorFailure(ctx, claims); cerr != nil {
+					return cerr
+				}
+			}
+		}
+	}
+	return err
+}
+
+// twoFactorFailure counts a wrong code entered with the token of claims and
+// revokes the token once it reaches maxTwoFactorFailures. Like the login
+// throttle, it counts in the cache and does nothing without one.
+func (s *AuthService) twoFactorFailure(ctx context.Context, claims *auth.Claims) error {
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if s.throttle.cache == nil || ttl <= 0 {
+		return nil
+	}
+	failures, err := s.throttle.cache.Increment(ctx, "2fa:fail:"+claims.ID, ttl)
+	if err != nil {
+		return err
+	}
+	if failures < maxTwoFactorFailures {
+		return nil
+	}
+	return s.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+}
+
+// checkSecondFactor accepts a TOTP code that was not used before or an unused
+// recovery code, and consumes it.
+func (s *AuthService) checkSecondFactor(ctx context.Context, tf *domain.TwoFactor, code string) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return domain.ErrInvalidTwoFactor
+	}
+	if step, ok := auth.ValidateTOTP(tf.Secret, code, time.Now()); ok {
+		return s.repo.UseTwoFactorStep(ctx, tf.UserID, step)
+	}
+	return s.repo.UseRecoveryCode(ctx, tf.UserID, auth.HashRecoveryCode(code))
+}
+
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	codes, err = auth.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range codes {
+		hashes = append(hashes, auth.HashRecoveryCode(c))
+	}
+	return codes, hashes, nil
+}
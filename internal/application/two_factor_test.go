@@ -0,0 +1,176 @@
+// internal/application/two_factor_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/adapters/revocation"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/ports"
+	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/pkg/auth"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthService_EnrollTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewAuthService(mockRepo, revocation.NewMemoryStore(), nil, nil, DefaultLockoutConfig())
+
+	ctx := context.Background()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, Username: "testuser@example.com"}, nil)
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(nil, nil)
+	var pending *domain.TwoFactor
+	mockRepo.EXPECT().SaveTwoFactor(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tf *domain.TwoFactor) error {
+		pending = tf
+		return nil
+	})
+	secret, uri, err := svc.EnrollTwoFactor(ctx, 1)
+	if err != nil || secret == "" || uri == "" || pending.Secret != secret {
+		t.Fatalf("EnrollTwoFactor() = %q, %q, %v, want the stored secret and its URI", secret, uri, err)
+	}
+
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(pending, nil)
+	if _, err := svc.ConfirmTwoFactor(ctx, 1, "000000x"); !errors.Is(err, domain.ErrInvalidTwoFactor) {
+		t.Fatalf("ConfirmTwoFactor() wrong code error = %v, want %v", err, domain.ErrInvalidTwoFactor)
+	}
+
+	code, _ := auth.TOTPCode(secret, time.Now())
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(pending, nil)
+	mockRepo.EXPECT().EnableTwoFactor(gomock.Any(), int64(1), gomock.Any(), gomock.Len(recoveryCodeCount)).Return(nil)
+	codes, err := svc.ConfirmTwoFactor(ctx, 1, code)
+	if err != nil || len(codes) != recoveryCodeCount {
+		t.Fatalf("ConfirmTwoFactor() = %v, %v, want %d recovery codes", codes, err, recoveryCodeCount)
+	}
+}
+
+func TestAuthService_TwoFactorLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewAuthService(mockRepo, revocation.NewMemoryStore(), nil, nil, DefaultLockoutConfig())
+
+	ctx := context.Background()
+	client := domain.ClientInfo{IP: "10.0.0.1"}
+	secret, _ := auth.GenerateTOTPSecret()
+	enabledAt := time.Now().UTC()
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("securepass1"), bcrypt.MinCost)
+	user := &domain.User{ID: 1, Username: "testuser@example.com", Password: string(hashed)}
+	tf := &domain.TwoFactor{UserID: 1, Secret: secret, EnabledAt: &enabledAt}
+	mockRepo.EXPECT().FindUserByUsername(gomock.Any(), user.Username).Return(user, nil).AnyTimes()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(user, nil).AnyTimes()
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(tf, nil).AnyTimes()
+
+	// The password alone only yields a challenge
+	tokens, _, err := svc.Login(ctx, user.Username, "securepass1", client)
+	if err != nil || tokens.ChallengeToken == "" || tokens.AccessToken != "" || tokens.RefreshToken != "" {
+		t.Fatalf("Login() = %v, %v, want only a challenge token", tokens, err)
+	}
+	challenge := tokens.ChallengeToken
+	if _, err := svc.Authenticate(ctx, challenge); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("Authenticate(challenge) error = %v, want %v", err, domain.ErrInvalidToken)
+	}
+
+	// A used or unknown recovery code is rejected
+	mockRepo.EXPECT().UseRecoveryCode(gomock.Any(), int64(1), auth.HashRecoveryCode("abcde-fghij")).Return(domain.ErrInvalidTwoFactor)
+	if _, err := svc.VerifyTwoFactor(ctx, challenge, "abcde-fghij", client); !errors.Is(err, domain.ErrInvalidTwoFactor) {
+		t.Fatalf("VerifyTwoFactor() bad code error = %v, want %v", err, domain.ErrInvalidTwoFactor)
+	}
+
+	// A replayed TOTP code is rejected
+	now := time.Now()
+	code, _ := auth.TOTPCode(secret, now)
+	mockRepo.EXPECT().UseTwoFactorStep(gomock.Any(), int64(1), gomock.Any()).Return(domain.ErrInvalidTwoFactor)
+	if _, err := svc.VerifyTwoFactor(ctx, challenge, code, client); !errors.Is(err, domain.ErrInvalidTwoFactor) {
+		t.Fatalf("VerifyTwoFactor() replayed code error = %v, want %v", err, domain.ErrInvalidTwoFactor)
+	}
+
+	mockRepo.EXPECT().UseTwoFactorStep(gomock.Any(), int64(1), now.Unix()/30).Return(nil)
+	mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+	tokens, err = svc.VerifyTwoFactor(ctx, challenge, code, client)
+	if err != nil || tokens.AccessToken == "" || tokens.RefreshToken == "" {
+		t.Fatalf("VerifyTwoFactor() = %v, %v, want a token pair", tokens, err)
+	}
+
+	// Challenge tokens are single use
+	if _, err := svc.VerifyTwoFactor(ctx, challenge, code, client); !errors.Is(err, domain.ErrInvalidChallenge) {
+		t.Fatalf("VerifyTwoFactor() reused challenge error = %v, want %v", err, domain.ErrInvalidChallenge)
+	}
+	access, _ := auth.GenerateToken(user.Username, 1, nil, "")
+	if _, err := svc.VerifyTwoFactor(ctx, access, code, client); !errors.Is(err, domain.ErrInvalidChallenge) {
+		t.Fatalf("VerifyTwoFactor() access token error = %v, want %v", err, domain.ErrInvalidChallenge)
+	}
+}
+
+func TestAuthService_TwoFactorFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	revocations := revocation.NewMemoryStore()
+	// A login throttle that never blocks, so only the per-token cap applies
+	lockout := LockoutConfig{MaxAttempts: 100, MaxAttemptsPerIP: 100, BaseDelay: time.Nanosecond, LockoutDuration: time.Minute, Window: time.Minute}
+	svc := NewAuthService(mockRepo, revocations, newMemoryCache(), nil, lockout)
+
+	ctx := context.Background()
+	secret, _ := auth.GenerateTOTPSecret()
+	enabledAt := time.Now().UTC()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, Username: "testuser@example.com"}, nil).AnyTimes()
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(&domain.TwoFactor{UserID: 1, Secret: secret, EnabledAt: &enabledAt}, nil).AnyTimes()
+	mockRepo.EXPECT().UseRecoveryCode(gomock.Any(), int64(1), gomock.Any()).Return(domain.ErrInvalidTwoFactor).AnyTimes()
+
+	// A challenge is invalidated after too many wrong codes
+	challenge, _ := auth.GenerateChallengeToken("testuser@example.com", 1)
+	for i := 0; i < maxTwoFactorFailures; i++ {
+		if _, err := svc.VerifyTwoFactor(ctx, challenge, "abcde-fghij", domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidTwoFactor) {
+			t.Fatalf("VerifyTwoFactor() wrong code %d error = %v, want %v", i+1, err, domain.ErrInvalidTwoFactor)
+		}
+	}
+	code, _ := auth.TOTPCode(secret, time.Now())
+	if _, err := svc.VerifyTwoFactor(ctx, challenge, code, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidChallenge) {
+		t.Fatalf("VerifyTwoFactor() after %d failures error = %v, want %v", maxTwoFactorFailures, err, domain.ErrInvalidChallenge)
+	}
+
+	// So is the access token used to guess codes for a logged-in user
+	access, _ := auth.GenerateToken("testuser@example.com", 1, nil, "")
+	claims, _ := auth.ValidateToken(access)
+	tokenCtx := context.WithValue(ctx, "token", access)
+	for i := 0; i < maxTwoFactorFailures; i++ {
+		if err := svc.DisableTwoFactor(tokenCtx, 1, "abcde-fghij"); !errors.Is(err, domain.ErrInvalidTwoFactor) {
+			t.Fatalf("DisableTwoFactor() wrong code %d error = %v, want %v", i+1, err, domain.ErrInvalidTwoFactor)
+		}
+	}
+	if revoked, _ := revocations.IsRevoked(ctx, claims.ID); !revoked {
+		t.Fatalf("access token not revoked after %d wrong codes", maxTwoFactorFailures)
+	}
+}
+
+func TestAuthService_DisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := ports.NewMockOrderRepositoryPort(ctrl)
+	svc := NewAuthService(mockRepo, revocation.NewMemoryStore(), nil, nil, DefaultLockoutConfig())
+
+	ctx := context.Background()
+	enabledAt := time.Now().UTC()
+	mockRepo.EXPECT().FindUserByID(gomock.Any(), int64(1)).Return(&domain.User{ID: 1, Username: "testuser@example.com"}, nil).AnyTimes()
+	mockRepo.EXPECT().FindTwoFactor(gomock.Any(), int64(1)).Return(&domain.TwoFactor{UserID: 1, Secret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", EnabledAt: &enabledAt}, nil).AnyTimes()
+
+	if err := svc.DisableTwoFactor(ctx, 1, ""); !errors.Is(err, domain.ErrInvalidTwoFactor) {
+		t.Fatalf("DisableTwoFactor() without code error = %v, want %v", err, domain.ErrInvalidTwoFactor)
+	}
+
+	mockRepo.EXPECT().UseRecoveryCode(gomock.Any(), int64(1), auth.HashRecoveryCode("abcde-fghij")).Return(nil)
+	mockRepo.EXPECT().DeleteTwoFactor(gomock.Any(), int64(1)).Return(nil)
+	if err := svc.DisableTwoFactor(ctx, 1, "ABCDE FGHIJ"); err != nil {
+		t.Fatalf("DisableTwoFactor() error: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+// internal/domain/consignment_id.go
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultConsignmentIDFormat is the prefix format of new consignment IDs:
+// "DA" and the creation date, e.g. DA251021.
+const DefaultConsignmentIDFormat = "DA{YY}{MM}{DD}"
+
+const (
+	consignmentSequenceDigits = 7
+	maxConsignmentIDLength    = 64
+)
+
+var consignmentIDPlaceholders = strings.NewReplacer("{YYYY}", "2006", "{YY}", "06", "{MM}", "01", "{DD}", "02")
+
+// consignmentIDPrefixChars are the characters allowed in a consignment ID
+// prefix. IDs are read out over the phone and typed in by hand, so they are
+// limited to upper case letters and digits.
+var consignmentIDPrefixChars = regexp.MustCompile(`^[A-Z0-9]*$`)
+
+// legacyConsignmentID matches the IDs issued before consignment IDs carried
+// a check digit. They are still accepted as input.
+var legacyConsignmentID = regexp.MustCompile(`^DA[0-9]{6}BNWWN[0-9]{1,3}$`)
+
+// ConsignmentIDPrefix expands a prefix format for the time t. The format is
+// made of upper case letters and digits plus the date placeholders {YYYY},
+// {YY}, {MM} and {DD}.
+func ConsignmentIDPrefix(format string, t time.Time) (string, error) {
+	// Split on the placeholders so that literal digits are never taken for
+	// parts of a date layout
+	var b strings.Builder
+	rest := format
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			b.WriteString(rest)
+			break
+		}
+		b.WriteString(rest[:start])
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("consignment id format %q has an unclosed placeholder", format)
+		}
+		placeholder := rest[start : start+end+1]
+		layout := consignmentIDPlaceholders.Replace(placeholder)
+		if layout == placeholder {
+			return "", fmt.Errorf("consignment id format %q has an unknown placeholder %s", format, placeholder)
+		}
+		b.WriteString(t.Format(layout))
+		rest = rest[start+end+1:]
+	}
+	prefix := b.String()
+	if !consignmentIDPrefixChars.MatchString(prefix) {
+		return "", fmt.Errorf("consignment id format %q may only contain upper case letters, digits and date placeholders", format)
+	}
+	return prefix, nil
+}
+
+// NewConsignmentID builds the consignment ID for the sequence number seq: the
+// prefix, seq padded to at least seven digits, and a check digit.
+func NewConsignmentID(prefix string, seq int64) string {
+	id := fmt.Sprintf("%s%0*d", prefix, consignmentSequenceDigits, seq)
+	return id + string(rune('0'+luhnCheckDigit(id)))
+}
+
+// ValidConsignmentID reports whether id is well-formed and its check digit
+// matches, which catches most typos before an order is looked up.
+func ValidConsignmentID(id string) bool {
+	if legacyConsignmentID.MatchString(id) {
+		return true
+	}
+	if len(id) < 2 || len(id) > maxConsignmentIDLength || !consignmentIDPrefixChars.MatchString(id) {
+		return false
+	}
+	last := id[len(id)-1]
+	if last < '0' || last > '9' {
+		return false
+	}
+	return luhnCheckDigit(id[:len(id)-1]) == int(last-'0')
+}
+
+// luhnCheckDigit returns the Luhn check digit of the digits in s. Letters are
+// skipped.
+func luhnCheckDigit(s string) int {
+	sum, double := 0, true
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return (10 - sum%10) % 10
+}
@@ -0,0 +1,62 @@
+// internal/domain/consignment_id_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConsignmentIDPrefix(t *testing.T) {
+	at := time.Date(2025, 10, 21, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		format, want string
+		ok           bool
+	}{
+		{DefaultConsignmentIDFormat, "DA251021", true},
+		{"BD{YYYY}{MM}", "BD202510", true},
+		{"X1", "X1", true},
+		{"", "", true},
+		{"DA{YY", "", false},
+		{"DA{HH}", "", false},
+		{"da{YY}", "", false},
+		{"DA-{YY}", "", false},
+	}
+	for _, tt := range tests {
+		got, err := ConsignmentIDPrefix(tt.format, at)
+		if tt.ok != (err == nil) || got != tt.want {
+			t.Errorf("ConsignmentIDPrefix(%q) = %q, %v, want %q, ok %v", tt.format, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestValidConsignmentID(t *testing.T) {
+	id := NewConsignmentID("DA251021", 123)
+	if len(id) != len("DA251021")+consignmentSequenceDigits+1 || id[:15] != "DA2510210000123" {
+		t.Fatalf("NewConsignmentID() = %q, want DA2510210000123 and a check digit", id)
+	}
+	if !ValidConsignmentID(id) {
+		t.Errorf("ValidConsignmentID(%q) = false, want true", id)
+	}
+	if !ValidConsignmentID(NewConsignmentID("", 123456789012)) {
+		t.Errorf("ValidConsignmentID() of an ID without prefix = false, want true")
+	}
+
+	// A changed digit or two swapped neighbours are caught
+	typos := []string{
+		id[:14] + "4" + id[15:],
+		id[:13] + id[14:15] + id[13:14] + id[15:],
+	}
+	for _, typo := range typos {
+		if ValidConsignmentID(typo) {
+			t.Errorf("ValidConsignmentID(%q) = true, want false", typo)
+		}
+	}
+	for _, id := range []string{"", "7", "DA251021BNWWN", "DA 2510210000123", "da2510210000123" + id[15:]} {
+		if ValidConsignmentID(id) {
+			t.Errorf("ValidConsignmentID(%q) = true, want false", id)
+		}
+	}
+	if !ValidConsignmentID("DA251021BNWWN123") {
+		t.Errorf("ValidConsignmentID() of a legacy ID = false, want true")
+	}
+}
@@ -0,0 +1,57 @@
+// internal/domain/errors.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidRefreshToken     = errors.New("invalid refresh token")
+	ErrRefreshTokenReused      = errors.New("refresh token reuse detected")
+	ErrInvalidToken            = errors.New("invalid token")
+	ErrTokenRevoked            = errors.New("token has been revoked")
+	ErrInvalidAPIKey           = errors.New("invalid api key")
+	ErrAPIKeyNotAllowed        = errors.New("api key is not allowed to call this method")
+	ErrInvalidResetToken       = errors.New("invalid or expired reset token")
+	ErrInvalidEmail            = errors.New("username must be a valid email address")
+	ErrEmailNotVerified        = errors.New("email address not verified")
+	ErrInvalidVerification     = errors.New("invalid or expired verification code")
+	ErrSessionNotFound         = errors.New("session not found or already revoked")
+	ErrInvalidChallenge        = errors.New("invalid or expired challenge token")
+	ErrInvalidTwoFactor        = errors.New("invalid two-factor code")
+	ErrTwoFactorEnabled        = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorDisabled       = errors.New("two-factor authentication is not enabled")
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+	ErrOrderStatusConflict     = errors.New("order status was changed concurrently")
+	ErrOrderNotEditable        = errors.New("order can no longer be edited")
+	ErrInvalidOrderFilter      = errors.New("invalid order filter")
+	ErrInvalidPageToken        = errors.New("invalid page token")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used for a different request")
+	ErrIdempotencyKeyExists    = errors.New("idempotency key already exists")
+	ErrDuplicateMerchantOrder  = errors.New("merchant order id already exists for this store")
+	ErrInvalidConsignmentID    = errors.New("invalid consignment id")
+	ErrInvalidRateCard         = errors.New("invalid rate card")
+	ErrNoRate                  = errors.New("no delivery rate for this destination")
+	ErrInvalidPromotion        = errors.New("invalid promotion")
+	ErrInvalidPromoCode        = errors.New("invalid or expired promo code")
+	ErrPromoNotApplicable      = errors.New("promo code does not apply to this order")
+	ErrPromoCodeExhausted      = errors.New("promo code usage limit reached")
+	ErrStoreNotFound           = errors.New("store not found")
+	ErrInvalidStore            = errors.New("invalid store")
+	ErrInvalidLocation         = errors.New("invalid recipient location")
+	ErrAreaNotServiceable      = errors.New("area is not serviceable")
+	ErrInvalidLocationCatalog  = errors.New("invalid location catalog")
+)
+
+// LockoutError is returned while logins for a username or client IP are
+// blocked after repeated failures.
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry in %s", e.RetryAfter.Round(time.Second))
+}
@@ -0,0 +1,156 @@
+// internal/domain/location.go
+package domain
+
+import "fmt"
+
+// City, Zone and Area are the levels of the location catalog orders are
+// delivered to: a city has zones and a zone has areas. IDs are unique within
+// their level. Orders are not taken for areas that are not Serviceable.
+type City struct {
+	ID   int64
+	Name string
+}
+
+type Zone struct {
+	ID     int64
+	CityID int64
+	Name   string
+}
+
+type Area struct {
+	ID          int64
+	ZoneID      int64
+	Name        string
+	Serviceable bool
+}
+
+// LocationCatalog is every city, zone and area orders can be sent to.
+type LocationCatalog struct {
+	Cities []City
+	Zones  []Zone
+	Areas  []Area
+}
+
+// Validate returns an error wrapping ErrInvalidLocationCatalog if an ID is
+// repeated, a name is missing or a zone or area refers to a parent that is
+// not in the catalog.
+func (c *LocationCatalog) Validate() error {
+	cities := make(map[int64]bool)
+	for _, city := range c.Cities {
+		if city.ID <= 0 || city.Name == "" || cities[city.ID] {
+			return fmt.Errorf("%w: city %d needs a unique positive id and a name", ErrInvalidLocationCatalog, city.ID)
+		}
+		cities[city.ID] = true
+	}
+	zones := make(map[int64]bool)
+	for _, zone := range c.Zones {
+		if zone.ID <= 0 || zone.Name == "" || zones[zone.ID] {
+			return fmt.Errorf("%w: zone %d needs a unique positive id and a name", ErrInvalidLocationCatalog, zone.ID)
+		}
+		if !cities[zone.CityID] {
+			return fmt.Errorf("%w: zone %d is in unknown city %d", ErrInvalidLocationCatalog, zone.ID, zone.CityID)
+		}
+		zones[zone.ID] = true
+	}
+	areas := make(map[int64]bool)
+	for _, area := range c.Areas {
+		if area.ID <= 0 || area.Name == "" || areas[area.ID] {
+			return fmt.Errorf("%w: area %d needs a unique positive id and a name", ErrInvalidLocationCatalog, area.ID)
+		}
+		if !zones[area.ZoneID] {
+			return fmt.Errorf("%w: area %d is in unknown zone %d", ErrInvalidLocationCatalog, area.ID, area.ZoneID)
+		}
+		areas[area.ID] = true
+	}
+	return nil
+}
+
+// ZonesOf returns the zones of a city.
+func (c *LocationCatalog) ZonesOf(cityID int64) []Zone {
+	var zones []Zone
+	for _, z := range c.Zones {
+		if z.CityID == cityID {
+			zones = append(zones, z)
+		}
+	}
+	return zones
+}
+
+// AreasOf returns the areas of a zone.
+func (c *LocationCatalog) AreasOf(zoneID int64) []Area {
+	var areas []Area
+	for _, a := range c.Areas {
+		if a.ZoneID == zoneID {
+			areas = append(areas, a)
+		}
+	}
+	return areas
+}
+
+// CheckDestination returns an error unless the recipient city, zone and area
+// of an order are in the catalog and belong together. The city is required;
+// the zone and area may be left out, but an area needs its zone. It fails with
+// ErrAreaNotServiceable for an area orders are not taken for, and with
+// ErrInvalidLocation otherwise.
+func (c *LocationCatalog) CheckDestination(o *Order) error {
+	if o.RecipientCity == 0 {
+		return fmt.Errorf("%w: recipient city is required", ErrInvalidLocation)
+	}
+	if !c.hasCity(o.RecipientCity) {
+		return fmt.Errorf("%w: unknown city %d", ErrInvalidLocation, o.RecipientCity)
+	}
+	if o.RecipientZone == 0 {
+		if o.RecipientArea != 0 {
+			return fmt.Errorf("%w: area %d needs its zone", ErrInvalidLocation, o.RecipientArea)
+		}
+		return nil
+	}
+	zone := c.zone(o.RecipientZone)
+	if zone == nil {
+		return fmt.Errorf("%w: unknown zone %d", ErrInvalidLocation, o.RecipientZone)
+	}
+	if zone.CityID != o.RecipientCity {
+		return fmt.Errorf("%w: zone %d is not in city %d", ErrInvalidLocation, zone.ID, o.RecipientCity)
+	}
+	if o.RecipientArea == 0 {
+		return nil
+	}
+	area := c.area(o.RecipientArea)
+	if area == nil {
+		return fmt.Errorf("%w: unknown area %d", ErrInvalidLocation, o.RecipientArea)
+	}
+	if area.ZoneID != zone.ID {
+		return fmt.Errorf("%w: area %d is not in zone %d", ErrInvalidLocation, area.ID, zone.ID)
+	}
+	if !area.Serviceable {
+		return fmt.Errorf("%w: %s", ErrAreaNotServiceable, area.Name)
+	}
+	return nil
+}
+
+func (c *LocationCatalog) hasCity(id int64) bool {
+	for _, city := range c.Cities {
+		if city.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *LocationCatalog) zone(id int64) *Zone {
+	for i := range c.Zones {
+		if c.Zones[i].ID == id {
+			return &c.Zones[i]
+		}
+	}
+	return nil
+}
+
+func (c *LocationCatalog) area(id int64) *Area {
+	for i := range c.Areas {
+		if c.Areas[i].ID == id {
+			return &c.Areas[i]
+		}
+	}
+	return nil
+}
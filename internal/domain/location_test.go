@@ -0,0 +1,71 @@
+// internal/domain/location_test.go
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func testCatalog() *LocationCatalog {
+	return &LocationCatalog{
+		Cities: []City{{ID: 1, Name: "Dhaka"}, {ID: 2, Name: "Chattogram"}},
+		Zones:  []Zone{{ID: 1, CityID: 1, Name: "Banani"}, {ID: 2, CityID: 1, Name: "Gulshan"}, {ID: 3, CityID: 2, Name: "Agrabad"}},
+		Areas: []Area{
+			{ID: 1, ZoneID: 1, Name: "Banani DOHS", Serviceable: true},
+			{ID: 2, ZoneID: 2, Name: "Gulshan 1", Serviceable: true},
+			{ID: 3, ZoneID: 3, Name: "Agrabad C/A", Serviceable: false},
+		},
+	}
+}
+
+func TestLocationCatalog_CheckDestination(t *testing.T) {
+	c := testCatalog()
+	tests := []struct {
+		name             string
+		city, zone, area int64
+		want             error
+	}{
+		{"full destination", 1, 1, 1, nil},
+		{"city only", 2, 0, 0, nil},
+		{"city and zone", 1, 2, 0, nil},
+		{"no city", 0, 1, 1, ErrInvalidLocation},
+		{"unknown city", 9, 0, 0, ErrInvalidLocation},
+		{"zone outside the city", 2, 1, 0, ErrInvalidLocation},
+		{"unknown zone", 1, 9, 0, ErrInvalidLocation},
+		{"area outside the zone", 1, 1, 2, ErrInvalidLocation},
+		{"area without zone", 1, 0, 1, ErrInvalidLocation},
+		{"unknown area", 1, 1, 9, ErrInvalidLocation},
+		{"unserviceable area", 2, 3, 3, ErrAreaNotServiceable},
+	}
+	for _, tt := range tests {
+		err := c.CheckDestination(&Order{RecipientCity: tt.city, RecipientZone: tt.zone, RecipientArea: tt.area})
+		if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: CheckDestination() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestLocationCatalog_Validate(t *testing.T) {
+	if err := testCatalog().Validate(); err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+	invalid := []*LocationCatalog{
+		{Cities: []City{{ID: 1, Name: "Dhaka"}, {ID: 1, Name: "Dhaka"}}},
+		{Cities: []City{{ID: 1}}},
+		{Cities: []City{{ID: 1, Name: "Dhaka"}}, Zones: []Zone{{ID: 1, CityID: 2, Name: "Agrabad"}}},
+		{Cities: []City{{ID: 1, Name: "Dhaka"}}, Zones: []Zone{{ID: 1, CityID: 1, Name: "Banani"}}, Areas: []Area{{ID: 1, ZoneID: 2, Name: "Gulshan 1"}}},
+	}
+	for i, c := range invalid {
+		if err := c.Validate(); !errors.Is(err, ErrInvalidLocationCatalog) {
+			t.Errorf("catalog %d: Validate() error = %v, want %v", i, err, ErrInvalidLocationCatalog)
+		}
+	}
+
+	c := testCatalog()
+	if zones := c.ZonesOf(1); len(zones) != 2 || zones[1].Name != "Gulshan" {
+		t.Errorf("ZonesOf(1) = %v, want Banani and Gulshan", zones)
+	}
+	if areas := c.AreasOf(3); len(areas) != 1 || areas[0].Serviceable {
+		t.Errorf("AreasOf(3) = %v, want the unserviceable Agrabad C/A", areas)
+	}
+}
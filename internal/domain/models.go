@@ -3,10 +3,153 @@ package domain
 
 import "time"
 
+const (
+	RoleMerchant = "merchant"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+// ValidRole reports whether role is one of the known roles.
+func ValidRole(role string) bool {
+	switch role {
+	case RoleMerchant, RoleOperator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
 type User struct {
-	ID       int64
-	Username string
-	Password string
+	ID            int64
+	Username      string
+	Password      string
+	Roles         []string
+	EmailVerified bool
+	// DiscountPercent is taken off the delivery fee of every order of the
+	// merchant.
+	DiscountPercent float64
+}
+
+// HasRole reports whether the user has been granted role.
+func (u *User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientInfo describes the client a request came from.
+type ClientInfo struct {
+	IP        string
+	Device    string
+	UserAgent string
+}
+
+// Session is one login of a user, typically one device. Its ID is the family
+// ID of the refresh tokens issued for that login, and access tokens carry it
+// in their sid claim.
+type Session struct {
+	ID         string
+	UserID     int64
+	Device     string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// TokenPair is the result of a login. When ChallengeToken is set the user
+// has two-factor authentication enabled, the other fields are empty and the
+// login has to be completed with the second factor.
+type TokenPair struct {
+	AccessToken    string
+	RefreshToken   string
+	ExpiresIn      int64
+	ChallengeToken string
+}
+
+// TwoFactor is the TOTP enrollment of a user. It is pending until the user
+// confirms it with a first code, which sets EnabledAt. LastStep is the TOTP
+// time step of the last accepted code, so a code cannot be used twice.
+type TwoFactor struct {
+	UserID    int64
+	Secret    string
+	LastStep  int64
+	CreatedAt time.Time
+	EnabledAt *time.Time
+}
+
+// Enabled reports whether the enrollment was confirmed.
+func (t *TwoFactor) Enabled() bool {
+	return t != nil && t.EnabledAt != nil
+}
+
+// APIKey is a long-lived credential for server-to-server calls. Only the hash
+// of the key is stored; Prefix is a non-secret lookup handle. A non-zero
+// StoreID limits the key to that store, and Methods lists the RPC names the
+// key may call.
+type APIKey struct {
+	ID         int64
+	UserID     int64
+	StoreID    int64
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Methods    []string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// AllowsMethod reports whether the key may call the RPC named method.
+func (k *APIKey) AllowsMethod(method string) bool {
+	for _, m := range k.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+type PasswordReset struct {
+	ID        int64
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+// EmailVerification is a code sent to a new user to prove they own the email
+// address they signed up with.
+type EmailVerification struct {
+	ID        int64
+	UserID    int64
+	CodeHash  string
+	Attempts  int64
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+// Notification is a message for a user, delivered by a ports.NotifierPort.
+type Notification struct {
+	To      string
+	Subject string
+	Body    string
 }
 
 type Order struct {
@@ -20,25 +163,40 @@ type Order struct {
 	RecipientCity     int64
 	RecipientZone     int64
 	RecipientArea     int64
-	OrderAmount       float64
-	TotalFee          float64
+	OrderAmount       Money
+	TotalFee          Money
 	Instruction       string
 	OrderTypeID       int64
-	CODFee            float64
-	PromoDiscount     float64
-	Discount          float64
-	DeliveryFee       float64
+	CODFee            Money
+	PromoDiscount     Money
+	Discount          Money
+	DeliveryFee       Money
 	Status            string
 	OrderType         string
 	ItemType          int64
 	StoreName         string
 	StoreContactPhone string
-	CODAmount         float64
-	DeliveryCharge    float64
+	CODAmount         Money
+	DeliveryCharge    Money
 	UserID            int64
 	StoreID           int64
 	DeliveryType      int64
 	ItemQuantity      int64
 	ItemWeight        float64
-	AmountToCollect   float64
-}
\ No newline at end of file
+	AmountToCollect   Money
+	ArchivedAt        *time.Time
+	RateCardVersion   int64
+	PromoCode         string
+}
+
+// IdempotencyKey records a request made with an Idempotency-Key header, so
+// that a retry of the request gets the original result instead of repeating
+// it. RequestHash identifies the request payload and Response holds the
+// serialized result.
+type IdempotencyKey struct {
+	UserID      int64
+	Key         string
+	RequestHash string
+	Response    []byte
+	CreatedAt   time.Time
+}
@@ -0,0 +1,122 @@
+// internal/domain/money.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency is the ISO 4217 code of every amount of Money.
+const Currency = "BDT"
+
+const poishaPerTaka = 100
+
+var errInvalidMoney = errors.New("invalid amount")
+
+// Money is an amount of taka as a whole number of poisha (1/100 taka), so
+// sums and differences are exact. Only percentages and products with a
+// weight are rounded, half away from zero, to the nearest poisha.
+//
+// In JSON, Money is a number of taka such as 92.5, so documents written
+// when amounts were floats read back unchanged.
+type Money int64
+
+// MoneyFromTaka converts an amount of taka, rounded to the nearest poisha.
+// It is meant for the edges of the system that still carry floats.
+func MoneyFromTaka(taka float64) Money {
+	return Money(math.Round(taka * poishaPerTaka))
+}
+
+// ParseMoney parses an amount of taka written with at most two decimals, such
+// as "1000", "92.5" or "-3.25", without going through a float.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	whole, frac, hasFrac := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	if whole == "" && frac == "" || len(frac) > 2 || hasFrac && frac == "" {
+		return 0, fmt.Errorf("%w %q", errInvalidMoney, s)
+	}
+	var taka, poisha int64
+	var err error
+	if whole != "" {
+		if taka, err = parseDigits(whole); err != nil {
+			return 0, fmt.Errorf("%w %q", errInvalidMoney, s)
+		}
+	}
+	if frac != "" {
+		if poisha, err = parseDigits(frac + strings.Repeat("0", 2-len(frac))); err != nil {
+			return 0, fmt.Errorf("%w %q", errInvalidMoney, s)
+		}
+	}
+	if taka > (math.MaxInt64-poisha)/poishaPerTaka {
+		return 0, fmt.Errorf("%w %q: out of range", errInvalidMoney, s)
+	}
+	m := Money(taka*poishaPerTaka + poisha)
+	if neg {
+		m = -m
+	}
+	return m, nil
+}
+
+func parseDigits(s string) (int64, error) {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, errInvalidMoney
+		}
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// Taka returns m as a float number of taka, for clients that read amounts as
+// floats.
+func (m Money) Taka() float64 {
+	return float64(m) / poishaPerTaka
+}
+
+// Percent returns p percent of m.
+func (m Money) Percent(p float64) Money {
+	return Money(math.Round(float64(m) * p / 100))
+}
+
+// Mul returns m multiplied by f, e.g. a fee per kilogram by a weight.
+func (m Money) Mul(f float64) Money {
+	return Money(math.Round(float64(m) * f))
+}
+
+// String formats m in taka with two decimals, e.g. "92.50".
+func (m Money) String() string {
+	sign := ""
+	u := uint64(m)
+	if m < 0 {
+		sign, u = "-", uint64(-m)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, u/poishaPerTaka, u%poishaPerTaka)
+}
+
+// MarshalJSON writes m as a number of taka without trailing zeros.
+func (m Money) MarshalJSON() ([]byte, error) {
+	s := strings.TrimSuffix(strings.TrimRight(m.String(), "0"), ".")
+	return []byte(s), nil
+}
+
+// UnmarshalJSON reads a number of taka. Values with more than two decimals,
+// written by float clients, are rounded to the nearest poisha.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		return nil
+	}
+	if v, err := ParseMoney(s); err == nil {
+		*m = v
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("%w %s", errInvalidMoney, s)
+	}
+	*m = MoneyFromTaka(f)
+	return nil
+}
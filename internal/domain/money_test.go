@@ -0,0 +1,67 @@
+// internal/domain/money_test.go
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	valid := map[string]Money{"1000": 100000, "92.5": 9250, "0.29": 29, ".5": 50, "-3.25": -325, " 7 ": 700}
+	for s, want := range valid {
+		if got, err := ParseMoney(s); err != nil || got != want {
+			t.Errorf("ParseMoney(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "-", "1.", "1.234", "1e3", "1,000", "abc", "99999999999999999999"} {
+		if _, err := ParseMoney(s); err == nil {
+			t.Errorf("ParseMoney(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestMoney_Rounding(t *testing.T) {
+	if got := MoneyFromTaka(0.29); got != 29 {
+		t.Errorf("MoneyFromTaka(0.29) = %d, want 29", got)
+	}
+	if got := Money(150).Percent(1); got != 2 {
+		t.Errorf("1%% of 1.50 = %v, want 0.02", got)
+	}
+	if got := Money(-150).Percent(1); got != -2 {
+		t.Errorf("1%% of -1.50 = %v, want -0.02", got)
+	}
+	if got := Money(1500).Mul(0.3333); got != 500 {
+		t.Errorf("15.00 * 0.3333 = %v, want 5.00", got)
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	tests := []struct {
+		m    Money
+		json string
+	}{
+		{0, "0"},
+		{100000, "1000"},
+		{9250, "92.5"},
+		{-325, "-3.25"},
+		{7, "0.07"},
+	}
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.m)
+		if err != nil || string(b) != tt.json {
+			t.Errorf("Marshal(%d) = %s, %v, want %s", tt.m, b, err, tt.json)
+		}
+		var m Money
+		if err := json.Unmarshal(b, &m); err != nil || m != tt.m {
+			t.Errorf("Unmarshal(%s) = %d, %v, want %d", b, m, err, tt.m)
+		}
+	}
+	// Float amounts written before Money existed still read back
+	var m Money
+	if err := json.Unmarshal([]byte("10.000000000000002"), &m); err != nil || m != 1000 {
+		t.Errorf("Unmarshal(10.000000000000002) = %d, %v, want 1000", m, err)
+	}
+	if s := Money(-5).String(); s != "-0.05" {
+		t.Errorf("String() = %s, want -0.05", s)
+	}
+}
@@ -0,0 +1,92 @@
+// internal/domain/order_filter.go
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Order stages for OrderFilter.Stage, exposed as transfer_status in the API.
+const (
+	OrderStageAny    = 0
+	OrderStageActive = 1
+	OrderStageFinal  = 2
+)
+
+// Sort orders for ListOrders. Ties are broken by consignment ID.
+const (
+	OrderSortCreatedDesc = "created_desc"
+	OrderSortCreatedAsc  = "created_asc"
+	OrderSortAmountDesc  = "amount_desc"
+	OrderSortAmountAsc   = "amount_asc"
+)
+
+const maxOrderSearchLength = 100
+
+// OrderFilter narrows the orders returned by ListOrders. Zero values match
+// every order, except that archived orders are only returned when Archived is
+// set, and then only those. CreatedFrom is inclusive and CreatedTo exclusive.
+// Search matches part of the recipient name or phone number.
+type OrderFilter struct {
+	Statuses        []string
+	Stage           int64
+	Archived        bool
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+	RecipientCity   int64
+	RecipientZone   int64
+	StoreID         int64
+	MerchantOrderID string
+	Search          string
+	Sort            string
+}
+
+// Validate normalizes the filter and returns an error wrapping
+// ErrInvalidOrderFilter if it cannot match any order by construction.
+func (f *OrderFilter) Validate() error {
+	for _, s := range f.Statuses {
+		if !ValidOrderStatus(s) {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidOrderFilter, s)
+		}
+	}
+	switch f.Stage {
+	case OrderStageAny, OrderStageActive, OrderStageFinal:
+	default:
+		return fmt.Errorf("%w: unknown transfer status %d", ErrInvalidOrderFilter, f.Stage)
+	}
+	if !f.CreatedFrom.IsZero() && !f.CreatedTo.IsZero() && !f.CreatedFrom.Before(f.CreatedTo) {
+		return fmt.Errorf("%w: created_from must be before created_to", ErrInvalidOrderFilter)
+	}
+	f.MerchantOrderID = strings.TrimSpace(f.MerchantOrderID)
+	f.Search = strings.TrimSpace(f.Search)
+	if len(f.Search) > maxOrderSearchLength {
+		return fmt.Errorf("%w: search is longer than %d characters", ErrInvalidOrderFilter, maxOrderSearchLength)
+	}
+	switch f.Sort {
+	case "":
+		f.Sort = OrderSortCreatedDesc
+	case OrderSortCreatedDesc, OrderSortCreatedAsc, OrderSortAmountDesc, OrderSortAmountAsc:
+	default:
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidOrderFilter, f.Sort)
+	}
+	return nil
+}
+
+// OrderCursor is the position of an order in a listing sorted by creation
+// time, used for keyset pagination. Ties are broken by consignment ID.
+type OrderCursor struct {
+	CreatedAt     time.Time
+	ConsignmentID string
+}
+
+// Keyset reports whether the filter's sort order supports cursor pagination.
+func (f *OrderFilter) Keyset() bool {
+	return f.Sort == OrderSortCreatedDesc || f.Sort == OrderSortCreatedAsc
+}
+
+// FinalOrderStatuses returns the statuses in which an order can no longer
+// change.
+func FinalOrderStatuses() []string {
+	return []string{OrderStatusDelivered, OrderStatusReturned, OrderStatusCancelled}
+}
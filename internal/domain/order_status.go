@@ -0,0 +1,74 @@
+// internal/domain/order_status.go
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Order statuses. A new order is Pending; Delivered, Returned and Cancelled
+// are final.
+const (
+	OrderStatusPending        = "Pending"
+	OrderStatusPickedUp       = "PickedUp"
+	OrderStatusInTransit      = "InTransit"
+	OrderStatusOutForDelivery = "OutForDelivery"
+	OrderStatusDelivered      = "Delivered"
+	OrderStatusReturned       = "Returned"
+	OrderStatusCancelled      = "Cancelled"
+)
+
+// orderTransitions lists the statuses an order may move to from each status.
+// A failed delivery attempt sends an order from OutForDelivery back to
+// InTransit. Only orders that have not been picked up can be cancelled; once
+// the parcel is with the courier it has to be returned instead.
+var orderTransitions = map[string][]string{
+	OrderStatusPending:        {OrderStatusPickedUp, OrderStatusCancelled},
+	OrderStatusPickedUp:       {OrderStatusInTransit, OrderStatusReturned},
+	OrderStatusInTransit:      {OrderStatusOutForDelivery, OrderStatusReturned},
+	OrderStatusOutForDelivery: {OrderStatusDelivered, OrderStatusInTransit, OrderStatusReturned},
+	OrderStatusDelivered:      nil,
+	OrderStatusReturned:       nil,
+	OrderStatusCancelled:      nil,
+}
+
+// ValidOrderStatus reports whether status is one of the known order statuses.
+func ValidOrderStatus(status string) bool {
+	_, ok := orderTransitions[status]
+	return ok
+}
+
+// FinalOrderStatus reports whether an order in status can no longer change.
+func FinalOrderStatus(status string) bool {
+	next, ok := orderTransitions[status]
+	return ok && len(next) == 0
+}
+
+// CheckOrderTransition returns an error wrapping ErrInvalidStatusTransition
+// unless an order may move from status from to status to.
+func CheckOrderTransition(from, to string) error {
+	if !ValidOrderStatus(to) {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidStatusTransition, to)
+	}
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, from, to)
+}
+
+// OrderStatusEvent records one status change of an order. ActorID is the user
+// who made the change. The first event of an order records its creation and
+// has an empty FromStatus. An edit of the order keeps its status and lists
+// the changed fields in Changes.
+type OrderStatusEvent struct {
+	ID            int64
+	ConsignmentID string
+	FromStatus    string
+	ToStatus      string
+	ActorID       int64
+	Reason        string
+	Changes       []OrderChange
+	CreatedAt     time.Time
+}
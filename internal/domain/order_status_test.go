@@ -0,0 +1,46 @@
+// internal/domain/order_status_test.go
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckOrderTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		ok       bool
+	}{
+		{OrderStatusPending, OrderStatusPickedUp, true},
+		{OrderStatusPending, OrderStatusCancelled, true},
+		{OrderStatusPickedUp, OrderStatusInTransit, true},
+		{OrderStatusInTransit, OrderStatusOutForDelivery, true},
+		{OrderStatusOutForDelivery, OrderStatusDelivered, true},
+		{OrderStatusOutForDelivery, OrderStatusInTransit, true},
+		{OrderStatusOutForDelivery, OrderStatusReturned, true},
+		{OrderStatusPending, OrderStatusDelivered, false},
+		{OrderStatusPickedUp, OrderStatusCancelled, false},
+		{OrderStatusDelivered, OrderStatusReturned, false},
+		{OrderStatusCancelled, OrderStatusPending, false},
+		{OrderStatusPending, "Lost", false},
+	}
+	for _, tt := range tests {
+		err := CheckOrderTransition(tt.from, tt.to)
+		if tt.ok != (err == nil) || (err != nil && !errors.Is(err, ErrInvalidStatusTransition)) {
+			t.Errorf("CheckOrderTransition(%s, %s) = %v, want ok %v", tt.from, tt.to, err, tt.ok)
+		}
+	}
+}
+
+func TestFinalOrderStatus(t *testing.T) {
+	for _, s := range []string{OrderStatusDelivered, OrderStatusReturned, OrderStatusCancelled} {
+		if !FinalOrderStatus(s) {
+			t.Errorf("FinalOrderStatus(%s) = false, want true", s)
+		}
+	}
+	for _, s := range []string{OrderStatusPending, OrderStatusOutForDelivery, "Lost"} {
+		if FinalOrderStatus(s) {
+			t.Errorf("FinalOrderStatus(%s) = true, want false", s)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// internal/domain/order_update.go
+package domain
+
+import "strconv"
+
+// OrderChange is a field of an order changed by an edit, with its values
+// before and after as text. Field is named like the field of the Order
+// message.
+type OrderChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// EditableOrder reports whether the details of an order in status can still
+// be edited. Once the parcel is picked up they are printed on its label.
+func EditableOrder(status string) bool {
+	return status == OrderStatusPending
+}
+
+// ApplyOrderEdit copies the fields a merchant may edit from edit to o: the
+// recipient, the parcel and the amount to collect. The store, merchant order
+// ID and promo code stay as booked.
+func ApplyOrderEdit(o, edit *Order) {
+	o.RecipientName = edit.RecipientName
+	o.RecipientPhone = edit.RecipientPhone
+	o.RecipientAddress = edit.RecipientAddress
+	o.RecipientCity = edit.RecipientCity
+	o.RecipientZone = edit.RecipientZone
+	o.RecipientArea = edit.RecipientArea
+	o.DeliveryType = edit.DeliveryType
+	o.ItemType = edit.ItemType
+	o.Instruction = edit.Instruction
+	o.ItemQuantity = edit.ItemQuantity
+	o.ItemWeight = edit.ItemWeight
+	o.AmountToCollect = edit.AmountToCollect
+	o.Description = edit.Description
+}
+
+// OrderChanges lists the fields that differ between two versions of an
+// order, including the fees an edit recomputed.
+func OrderChanges(before, after *Order) []OrderChange {
+	var changes []OrderChange
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, OrderChange{Field: field, From: from, To: to})
+		}
+	}
+	id := func(v int64) string { return strconv.FormatInt(v, 10) }
+	add("recipient_name", before.RecipientName, after.RecipientName)
+	add("recipient_phone", before.RecipientPhone, after.RecipientPhone)
+	add("recipient_address", before.RecipientAddress, after.RecipientAddress)
+	add("recipient_city", id(before.RecipientCity), id(after.RecipientCity))
+	add("recipient_zone", id(before.RecipientZone), id(after.RecipientZone))
+	add("recipient_area", id(before.RecipientArea), id(after.RecipientArea))
+	add("delivery_type", id(before.DeliveryType), id(after.DeliveryType))
+	add("item_type", id(before.ItemType), id(after.ItemType))
+	add("instruction", before.Instruction, after.Instruction)
+	add("item_quantity", id(before.ItemQuantity), id(after.ItemQuantity))
+	add("item_weight", strconv.FormatFloat(before.ItemWeight, 'f', -1, 64), strconv.FormatFloat(after.ItemWeight, 'f', -1, 64))
+	add("amount_to_collect", before.AmountToCollect.String(), after.AmountToCollect.String())
+	add("order_description", before.Description, after.Description)
+	add("delivery_fee", before.DeliveryFee.String(), after.DeliveryFee.String())
+	add("cod_fee", before.CODFee.String(), after.CODFee.String())
+	add("discount", before.Discount.String(), after.Discount.String())
+	add("promo_discount", before.PromoDiscount.String(), after.PromoDiscount.String())
+	add("total_fee", before.TotalFee.String(), after.TotalFee.String())
+	add("rate_card_version", id(before.RateCardVersion), id(after.RateCardVersion))
+	return changes
+}
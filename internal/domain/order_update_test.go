@@ -0,0 +1,37 @@
+// internal/domain/order_update_test.go
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditableOrder(t *testing.T) {
+	for status := range orderTransitions {
+		if got, want := EditableOrder(status), status == OrderStatusPending; got != want {
+			t.Errorf("EditableOrder(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestOrderChanges(t *testing.T) {
+	before := &Order{ConsignmentID: "DA251021BNWWN123", RecipientAddress: "Banani", ItemWeight: 0.5, AmountToCollect: 100000, DeliveryFee: 6000, PromoCode: "EID"}
+	after := *before
+	ApplyOrderEdit(&after, &Order{RecipientAddress: "Gulshan 2", ItemWeight: 1.25, AmountToCollect: 120050})
+	after.DeliveryFee = 7125
+	want := []OrderChange{
+		{Field: "recipient_address", From: "Banani", To: "Gulshan 2"},
+		{Field: "item_weight", From: "0.5", To: "1.25"},
+		{Field: "amount_to_collect", From: "1000.00", To: "1200.50"},
+		{Field: "delivery_fee", From: "60.00", To: "71.25"},
+	}
+	if got := OrderChanges(before, &after); !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderChanges() = %+v, want %+v", got, want)
+	}
+	if after.ConsignmentID != before.ConsignmentID || after.PromoCode != "EID" {
+		t.Errorf("ApplyOrderEdit() changed fields that are not editable: %+v", after)
+	}
+	if got := OrderChanges(before, before); got != nil {
+		t.Errorf("OrderChanges() of the same order = %+v, want nil", got)
+	}
+}
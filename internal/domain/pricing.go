@@ -0,0 +1,202 @@
+// internal/domain/pricing.go
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateCard is one version of the delivery prices. A card prices the orders
+// created from EffectiveFrom until a later card takes effect. Cards are never
+// changed once stored, so the version recorded on an order always explains
+// its fees.
+type RateCard struct {
+	Version        int64
+	EffectiveFrom  time.Time
+	CODPercent     float64
+	MinCODFee      Money
+	MinDeliveryFee Money
+	Rates          []Rate
+	CreatedBy      int64
+	CreatedAt      time.Time
+}
+
+// Rate is the delivery fee of the orders matching its destination, delivery
+// type and item type; zero fields match any value. A parcel up to the first
+// weight band pays BaseFee plus that band's surcharge, and so on; beyond the
+// last band it pays that band's surcharge, OverweightFee, and ExtraKgFee for
+// every kilogram over it, rounded to the nearest poisha.
+type Rate struct {
+	City          int64
+	Zone          int64
+	Area          int64
+	DeliveryType  int64
+	ItemType      int64
+	BaseFee       Money
+	WeightBands   []WeightBand
+	OverweightFee Money
+	ExtraKgFee    Money
+}
+
+// WeightBand is the surcharge for parcels heavier than the previous band and
+// up to MaxWeight kilograms.
+type WeightBand struct {
+	MaxWeight float64
+	Surcharge Money
+}
+
+// Fees is the price of an order and how it was computed. TotalFee is the
+// delivery fee plus the cash on delivery fee, less the merchant's Discount and
+// the PromoDiscount of a promo code.
+type Fees struct {
+	RateCardVersion int64
+	BaseFee         Money
+	WeightSurcharge Money
+	DeliveryFee     Money
+	CODFee          Money
+	Discount        Money
+	PromoDiscount   Money
+	TotalFee        Money
+}
+
+// DefaultRateCard is the card stored when there is none yet. It keeps the
+// prices used before rate cards: up to 0.5 kg costs 60 taka in city 1 and 100
+// elsewhere, up to 1 kg costs 70 everywhere, heavier parcels cost 10 more than
+// the 0.5 kg price plus 15 per kilogram over 1 kg, and cash on delivery costs
+// 1%.
+func DefaultRateCard() *RateCard {
+	return &RateCard{
+		CODPercent: 1,
+		Rates: []Rate{
+			{
+				BaseFee:       7000,
+				WeightBands:   []WeightBand{{MaxWeight: 0.5, Surcharge: 3000}, {MaxWeight: 1, Surcharge: 0}},
+				OverweightFee: 4000,
+				ExtraKgFee:    1500,
+			},
+			{
+				City:        1,
+				BaseFee:     6000,
+				WeightBands: []WeightBand{{MaxWeight: 0.5, Surcharge: 0}, {MaxWeight: 1, Surcharge: 1000}},
+				ExtraKgFee:  1500,
+			},
+		},
+	}
+}
+
+// Validate returns an error wrapping ErrInvalidRateCard if the card cannot be
+// used to price orders.
+func (c *RateCard) Validate() error {
+	if c.CODPercent < 0 || c.CODPercent > 100 {
+		return fmt.Errorf("%w: cod percent must be between 0 and 100", ErrInvalidRateCard)
+	}
+	if c.MinCODFee < 0 || c.MinDeliveryFee < 0 {
+		return fmt.Errorf("%w: minimum fees must not be negative", ErrInvalidRateCard)
+	}
+	if len(c.Rates) == 0 {
+		return fmt.Errorf("%w: at least one rate is required", ErrInvalidRateCard)
+	}
+	seen := make(map[[5]int64]bool)
+	for i, r := range c.Rates {
+		key := r.key()
+		if seen[key] {
+			return fmt.Errorf("%w: rate %d repeats the destination, delivery type and item type of another rate", ErrInvalidRateCard, i+1)
+		}
+		seen[key] = true
+		if r.City < 0 || r.Zone < 0 || r.Area < 0 || r.DeliveryType < 0 || r.ItemType < 0 {
+			return fmt.Errorf("%w: rate %d has a negative id", ErrInvalidRateCard, i+1)
+		}
+		if r.BaseFee < 0 || r.OverweightFee < 0 || r.ExtraKgFee < 0 {
+			return fmt.Errorf("%w: rate %d has a negative fee", ErrInvalidRateCard, i+1)
+		}
+		prev := 0.0
+		for _, b := range r.WeightBands {
+			if b.MaxWeight <= prev {
+				return fmt.Errorf("%w: weight bands of rate %d must be in increasing order", ErrInvalidRateCard, i+1)
+			}
+			if b.Surcharge < 0 {
+				return fmt.Errorf("%w: rate %d has a negative surcharge", ErrInvalidRateCard, i+1)
+			}
+			prev = b.MaxWeight
+		}
+	}
+	return nil
+}
+
+func (r *Rate) key() [5]int64 {
+	return [5]int64{r.City, r.Zone, r.Area, r.DeliveryType, r.ItemType}
+}
+
+// specificity ranks the rates matching an order: a rate for an area beats one
+// for a zone, which beats one for a city, and a rate for a delivery type beats
+// one for an item type. Every field has its own bit, so two different rates
+// matching the same order never rank equal.
+func (r *Rate) specificity() int {
+	score := 0
+	for i, v := range r.key() {
+		if v != 0 {
+			score |= 1 << (4 - i)
+		}
+	}
+	return score
+}
+
+func (r *Rate) matches(o *Order) bool {
+	return (r.City == 0 || r.City == o.RecipientCity) &&
+		(r.Zone == 0 || r.Zone == o.RecipientZone) &&
+		(r.Area == 0 || r.Area == o.RecipientArea) &&
+		(r.DeliveryType == 0 || r.DeliveryType == o.DeliveryType) &&
+		(r.ItemType == 0 || r.ItemType == o.ItemType)
+}
+
+// Price computes the fees of an order: the delivery fee of the most specific
+// matching rate and the cash on delivery fee. It fails with ErrNoRate if no
+// rate matches.
+func (c *RateCard) Price(o *Order) (Fees, error) {
+	var rate *Rate
+	for i := range c.Rates {
+		r := &c.Rates[i]
+		if r.matches(o) && (rate == nil || r.specificity() > rate.specificity()) {
+			rate = r
+		}
+	}
+	if rate == nil {
+		return Fees{}, ErrNoRate
+	}
+
+	fees := Fees{RateCardVersion: c.Version, BaseFee: rate.BaseFee}
+	overweight := o.ItemWeight
+	for _, b := range rate.WeightBands {
+		fees.WeightSurcharge = b.Surcharge
+		overweight = o.ItemWeight - b.MaxWeight
+		if overweight <= 0 {
+			break
+		}
+	}
+	if overweight > 0 {
+		fees.WeightSurcharge += rate.OverweightFee + rate.ExtraKgFee.Mul(overweight)
+	}
+	fees.DeliveryFee = max(fees.BaseFee+fees.WeightSurcharge, c.MinDeliveryFee)
+	if o.AmountToCollect > 0 {
+		fees.CODFee = max(o.AmountToCollect.Percent(c.CODPercent), c.MinCODFee)
+	}
+	fees.TotalFee = fees.DeliveryFee + fees.CODFee
+	return fees, nil
+}
+
+// CurrentRateCard returns the card in effect at t, the one that took effect
+// last, or nil if none has. Cards with the same EffectiveFrom are ordered by
+// version.
+func CurrentRateCard(cards []*RateCard, t time.Time) *RateCard {
+	var current *RateCard
+	for _, c := range cards {
+		if c.EffectiveFrom.After(t) {
+			continue
+		}
+		if current == nil || c.EffectiveFrom.After(current.EffectiveFrom) ||
+			(c.EffectiveFrom.Equal(current.EffectiveFrom) && c.Version > current.Version) {
+			current = c
+		}
+	}
+	return current
+}
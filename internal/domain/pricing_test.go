@@ -0,0 +1,108 @@
+// internal/domain/pricing_test.go
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateCard_Price(t *testing.T) {
+	card := DefaultRateCard()
+	card.Version = 3
+	tests := []struct {
+		name                      string
+		order                     Order
+		base, surcharge, cod, fee Money
+	}{
+		{"city 1, light", Order{RecipientCity: 1, ItemWeight: 0.5, AmountToCollect: 100000}, 6000, 0, 1000, 7000},
+		{"city 1, up to 1 kg", Order{RecipientCity: 1, ItemWeight: 0.8, AmountToCollect: 100000}, 6000, 1000, 1000, 8000},
+		{"city 1, heavy", Order{RecipientCity: 1, ItemWeight: 1.5, AmountToCollect: 100000}, 6000, 1750, 1000, 8750},
+		{"other city", Order{RecipientCity: 2, ItemWeight: 0.5}, 7000, 3000, 0, 10000},
+		{"other city, heavy", Order{RecipientCity: 2, ItemWeight: 1.5}, 7000, 4750, 0, 11750},
+		// 1% of 999.99 is 9.9999, rounded to the poisha
+		{"fractional cod fee", Order{RecipientCity: 1, ItemWeight: 0.5, AmountToCollect: 99999}, 6000, 0, 1000, 7000},
+		{"half a poisha", Order{RecipientCity: 1, ItemWeight: 0.5, AmountToCollect: 150}, 6000, 0, 2, 6002},
+	}
+	for _, tt := range tests {
+		fees, err := card.Price(&tt.order)
+		if err != nil {
+			t.Fatalf("%s: Price() error: %v", tt.name, err)
+		}
+		if fees.RateCardVersion != 3 || fees.BaseFee != tt.base || fees.WeightSurcharge != tt.surcharge || fees.CODFee != tt.cod || fees.TotalFee != tt.fee {
+			t.Errorf("%s: Price() = %+v, want base %v, surcharge %v, cod %v, total %v", tt.name, fees, tt.base, tt.surcharge, tt.cod, tt.fee)
+		}
+	}
+
+	// The default card keeps the prices used before rate cards
+	baseline := []struct {
+		city   int64
+		weight float64
+		fee    Money
+	}{
+		{1, 0.5, 6000}, {1, 1, 7000}, {1, 2, 8500},
+		{2, 0.5, 10000}, {2, 1, 7000}, {2, 2, 12500},
+	}
+	for _, tt := range baseline {
+		fees, err := DefaultRateCard().Price(&Order{RecipientCity: tt.city, ItemWeight: tt.weight})
+		if err != nil || fees.DeliveryFee != tt.fee {
+			t.Errorf("city %d, %v kg: Price() = %+v, %v, want delivery fee %v", tt.city, tt.weight, fees, err, tt.fee)
+		}
+	}
+
+	// The most specific rate wins and minimums apply
+	card = &RateCard{
+		CODPercent:     1,
+		MinCODFee:      2000,
+		MinDeliveryFee: 5000,
+		Rates: []Rate{
+			{City: 1, BaseFee: 6000},
+			{City: 1, Zone: 5, BaseFee: 4500},
+			{City: 1, DeliveryType: 48, BaseFee: 8000},
+			{City: 1, Zone: 5, Area: 9, BaseFee: 7000},
+		},
+	}
+	fees, err := card.Price(&Order{RecipientCity: 1, RecipientZone: 5, DeliveryType: 48, AmountToCollect: 50000})
+	if err != nil || fees.BaseFee != 4500 || fees.DeliveryFee != 5000 || fees.CODFee != 2000 {
+		t.Errorf("Price() = %+v, %v, want the zone rate raised to the minimums", fees, err)
+	}
+	if _, err := card.Price(&Order{RecipientCity: 2}); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Price() without a matching rate error = %v, want %v", err, ErrNoRate)
+	}
+}
+
+func TestRateCard_Validate(t *testing.T) {
+	if err := DefaultRateCard().Validate(); err != nil {
+		t.Fatalf("DefaultRateCard().Validate() error: %v", err)
+	}
+	invalid := []*RateCard{
+		{CODPercent: 101, Rates: []Rate{{}}},
+		{MinCODFee: -1, Rates: []Rate{{}}},
+		{},
+		{Rates: []Rate{{City: 1}, {City: 1}}},
+		{Rates: []Rate{{BaseFee: -5}}},
+		{Rates: []Rate{{OverweightFee: -5}}},
+		{Rates: []Rate{{WeightBands: []WeightBand{{MaxWeight: 1}, {MaxWeight: 1}}}}},
+	}
+	for i, c := range invalid {
+		if err := c.Validate(); !errors.Is(err, ErrInvalidRateCard) {
+			t.Errorf("card %d: Validate() error = %v, want %v", i, err, ErrInvalidRateCard)
+		}
+	}
+}
+
+func TestCurrentRateCard(t *testing.T) {
+	now := time.Now()
+	cards := []*RateCard{
+		{Version: 1, EffectiveFrom: now.Add(-48 * time.Hour)},
+		{Version: 2, EffectiveFrom: now.Add(-time.Hour)},
+		{Version: 3, EffectiveFrom: now.Add(-time.Hour)},
+		{Version: 4, EffectiveFrom: now.Add(time.Hour)},
+	}
+	if c := CurrentRateCard(cards, now); c == nil || c.Version != 3 {
+		t.Errorf("CurrentRateCard() = %+v, want version 3", c)
+	}
+	if c := CurrentRateCard(cards, now.Add(-72*time.Hour)); c != nil {
+		t.Errorf("CurrentRateCard() before the first card = %+v, want nil", c)
+	}
+}
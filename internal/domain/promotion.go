@@ -0,0 +1,129 @@
+// internal/domain/promotion.go
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Promotion kinds: a percentage of the delivery fee or a flat amount off it.
+const (
+	PromotionPercent = "percent"
+	PromotionFlat    = "flat"
+)
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// Promotion is a promo code merchants can enter when they create an order.
+// Percent promotions take Value percent off the delivery fee, capped at
+// MaxDiscount if set, and flat promotions take Amount off it. A promotion is
+// valid from StartsAt until EndsAt, if set. MaxRedemptions caps the uses
+// overall and MaxPerMerchant the uses per merchant; zero means no cap. A
+// non-zero MerchantID limits the code to that merchant, and non-empty Cities
+// and DeliveryTypes limit it to orders going there or sent that way.
+type Promotion struct {
+	ID             int64
+	Code           string
+	Kind           string
+	Value          float64
+	Amount         Money
+	MaxDiscount    Money
+	StartsAt       time.Time
+	EndsAt         *time.Time
+	MaxRedemptions int64
+	MaxPerMerchant int64
+	MerchantID     int64
+	Cities         []int64
+	DeliveryTypes  []int64
+	Redemptions    int64
+	CreatedBy      int64
+	CreatedAt      time.Time
+}
+
+// NormalizePromoCode returns code the way promo codes are stored: trimmed and
+// in upper case.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate normalizes the promotion and returns an error wrapping
+// ErrInvalidPromotion if it cannot be used.
+func (p *Promotion) Validate() error {
+	p.Code = NormalizePromoCode(p.Code)
+	if !promoCodePattern.MatchString(p.Code) {
+		return fmt.Errorf("%w: code must be 3 to 32 letters, digits, dashes or underscores", ErrInvalidPromotion)
+	}
+	switch p.Kind {
+	case PromotionPercent:
+		if p.Value <= 0 || p.Value > 100 {
+			return fmt.Errorf("%w: percent must be between 0 and 100", ErrInvalidPromotion)
+		}
+	case PromotionFlat:
+		if p.Amount <= 0 {
+			return fmt.Errorf("%w: flat discount must be positive", ErrInvalidPromotion)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidPromotion, p.Kind)
+	}
+	if p.MaxDiscount < 0 || p.MaxRedemptions < 0 || p.MaxPerMerchant < 0 {
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidPromotion)
+	}
+	if p.EndsAt != nil && !p.EndsAt.After(p.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidPromotion)
+	}
+	return nil
+}
+
+// CheckEligible returns an error unless the promotion applies to an order
+// created at t. Usage caps are checked when the code is redeemed.
+func (p *Promotion) CheckEligible(o *Order, t time.Time) error {
+	if t.Before(p.StartsAt) || (p.EndsAt != nil && !t.Before(*p.EndsAt)) {
+		return ErrInvalidPromoCode
+	}
+	if p.MerchantID != 0 && p.MerchantID != o.UserID {
+		return ErrInvalidPromoCode
+	}
+	if len(p.Cities) > 0 && !containsID(p.Cities, o.RecipientCity) {
+		return fmt.Errorf("%w: not valid for this city", ErrPromoNotApplicable)
+	}
+	if len(p.DeliveryTypes) > 0 && !containsID(p.DeliveryTypes, o.DeliveryType) {
+		return fmt.Errorf("%w: not valid for this delivery type", ErrPromoNotApplicable)
+	}
+	return nil
+}
+
+// Discount returns the discount of the promotion on a delivery fee. It never
+// exceeds the fee.
+func (p *Promotion) Discount(fee Money) Money {
+	discount := p.Amount
+	if p.Kind == PromotionPercent {
+		discount = fee.Percent(p.Value)
+		if p.MaxDiscount > 0 {
+			discount = min(discount, p.MaxDiscount)
+		}
+	}
+	return min(discount, fee)
+}
+
+// ApplyDiscounts takes the merchant's discount percent and then the
+// promotion, if any, off the delivery fee and updates TotalFee. The cash on
+// delivery fee is never discounted.
+func (f *Fees) ApplyDiscounts(merchantPercent float64, promo *Promotion) {
+	f.Discount = f.DeliveryFee.Percent(merchantPercent)
+	f.PromoDiscount = 0
+	if promo != nil {
+		f.PromoDiscount = promo.Discount(f.DeliveryFee - f.Discount)
+	}
+	f.TotalFee = f.DeliveryFee + f.CODFee - f.Discount - f.PromoDiscount
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// internal/domain/promotion_test.go
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPromotion_Validate(t *testing.T) {
+	now := time.Now()
+	p := &Promotion{Code: " eid-25 ", Kind: PromotionPercent, Value: 25, StartsAt: now}
+	if err := p.Validate(); err != nil || p.Code != "EID-25" {
+		t.Fatalf("Validate() = %v with code %q, want nil with code EID-25", err, p.Code)
+	}
+	earlier := now.Add(-time.Hour)
+	invalid := []*Promotion{
+		{Code: "A", Kind: PromotionFlat, Amount: 1000, StartsAt: now},
+		{Code: "FREE SHIP", Kind: PromotionFlat, Amount: 1000, StartsAt: now},
+		{Code: "HALF", Kind: PromotionPercent, Value: 150, StartsAt: now},
+		{Code: "FLAT", Kind: PromotionFlat, Value: 10, StartsAt: now},
+		{Code: "BOGO", Kind: "bogo", Value: 10, StartsAt: now},
+		{Code: "CAPPED", Kind: PromotionFlat, Amount: 1000, MaxPerMerchant: -1, StartsAt: now},
+		{Code: "EXPIRED", Kind: PromotionFlat, Amount: 1000, StartsAt: now, EndsAt: &earlier},
+	}
+	for _, p := range invalid {
+		if err := p.Validate(); !errors.Is(err, ErrInvalidPromotion) {
+			t.Errorf("%s: Validate() error = %v, want %v", p.Code, err, ErrInvalidPromotion)
+		}
+	}
+}
+
+func TestPromotion_CheckEligible(t *testing.T) {
+	now := time.Now()
+	ends := now.Add(time.Hour)
+	p := &Promotion{StartsAt: now.Add(-time.Hour), EndsAt: &ends, MerchantID: 7, Cities: []int64{1}, DeliveryTypes: []int64{48}}
+	order := &Order{UserID: 7, RecipientCity: 1, DeliveryType: 48}
+	if err := p.CheckEligible(order, now); err != nil {
+		t.Fatalf("CheckEligible() error: %v", err)
+	}
+	tests := []struct {
+		name  string
+		order Order
+		at    time.Time
+		want  error
+	}{
+		{"not started", *order, now.Add(-2 * time.Hour), ErrInvalidPromoCode},
+		{"ended", *order, ends, ErrInvalidPromoCode},
+		{"other merchant", Order{UserID: 8, RecipientCity: 1, DeliveryType: 48}, now, ErrInvalidPromoCode},
+		{"other city", Order{UserID: 7, RecipientCity: 2, DeliveryType: 48}, now, ErrPromoNotApplicable},
+		{"other delivery type", Order{UserID: 7, RecipientCity: 1, DeliveryType: 12}, now, ErrPromoNotApplicable},
+	}
+	for _, tt := range tests {
+		if err := p.CheckEligible(&tt.order, tt.at); !errors.Is(err, tt.want) {
+			t.Errorf("%s: CheckEligible() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestFees_ApplyDiscounts(t *testing.T) {
+	tests := []struct {
+		name                       string
+		percent                    float64
+		promo                      *Promotion
+		discount, promoDisc, total Money
+	}{
+		{"none", 0, nil, 0, 0, 11000},
+		{"merchant", 10, nil, 1000, 0, 10000},
+		{"percent promo after merchant", 10, &Promotion{Kind: PromotionPercent, Value: 50}, 1000, 4500, 5500},
+		{"capped percent promo", 0, &Promotion{Kind: PromotionPercent, Value: 50, MaxDiscount: 2000}, 0, 2000, 9000},
+		{"flat promo above the fee", 0, &Promotion{Kind: PromotionFlat, Amount: 15000}, 0, 10000, 1000},
+		{"rounded percent", 2.5, &Promotion{Kind: PromotionPercent, Value: 33}, 250, 3218, 7532},
+	}
+	for _, tt := range tests {
+		fees := Fees{DeliveryFee: 10000, CODFee: 1000, TotalFee: 11000}
+		fees.ApplyDiscounts(tt.percent, tt.promo)
+		if fees.Discount != tt.discount || fees.PromoDiscount != tt.promoDisc || fees.TotalFee != tt.total {
+			t.Errorf("%s: ApplyDiscounts() = %+v, want discount %v, promo discount %v, total %v", tt.name, fees, tt.discount, tt.promoDisc, tt.total)
+		}
+	}
+}
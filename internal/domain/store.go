@@ -0,0 +1,19 @@
+// internal/domain/store.go
+package domain
+
+import "time"
+
+// Store is a pickup point of a merchant. Orders are booked for one of the
+// merchant's stores and carry its name and contact phone. Orders of the
+// store that leave the delivery type unset are sent with
+// DefaultDeliveryType.
+type Store struct {
+	ID                  int64
+	UserID              int64
+	Name                string
+	ContactPhone        string
+	PickupAddress       string
+	DefaultDeliveryType int64
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
@@ -7,6 +7,7 @@ package ports
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
@@ -36,19 +37,19 @@ func (m *MockAuthPort) EXPECT() *MockAuthPortMockRecorder {
 }
 
 // Login mocks base method.
-func (m *MockAuthPort) Login(ctx context.Context, username, password string) (string, *domain.User, error) {
+func (m *MockAuthPort) Login(ctx context.Context, username, password string, client domain.ClientInfo) (*domain.TokenPair, *domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, username, password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Login", ctx, username, password, client)
+	ret0, _ := ret[0].(*domain.TokenPair)
 	ret1, _ := ret[1].(*domain.User)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockAuthPortMockRecorder) Login(ctx, username, password interface{}) *gomock.Call {
+func (mr *MockAuthPortMockRecorder) Login(ctx, username, password, client interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthPort)(nil).Login), ctx, username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthPort)(nil).Login), ctx, username, password, client)
 }
 
 // Logout mocks base method.
@@ -65,6 +66,21 @@ func (mr *MockAuthPortMockRecorder) Logout(ctx, userID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthPort)(nil).Logout), ctx, userID)
 }
 
+// RefreshToken mocks base method.
+func (m *MockAuthPort) RefreshToken(ctx context.Context, refreshToken string, client domain.ClientInfo) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken, client)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthPortMockRecorder) RefreshToken(ctx, refreshToken, client interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthPort)(nil).RefreshToken), ctx, refreshToken, client)
+}
+
 // Signup mocks base method.
 func (m *MockAuthPort) Signup(ctx context.Context, username, password string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -103,18 +119,92 @@ func (m *MockOrderRepositoryPort) EXPECT() *MockOrderRepositoryPortMockRecorder
 	return m.recorder
 }
 
-// CancelOrder mocks base method.
-func (m *MockOrderRepositoryPort) CancelOrder(ctx context.Context, consignmentID string, userID int64) error {
+// ArchiveFinalOrders mocks base method.
+func (m *MockOrderRepositoryPort) ArchiveFinalOrders(ctx context.Context, finalBefore, at time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveFinalOrders", ctx, finalBefore, at)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveFinalOrders indicates an expected call of ArchiveFinalOrders.
+func (mr *MockOrderRepositoryPortMockRecorder) ArchiveFinalOrders(ctx, finalBefore, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveFinalOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ArchiveFinalOrders), ctx, finalBefore, at)
+}
+
+// ArchiveOrders mocks base method.
+func (m *MockOrderRepositoryPort) ArchiveOrders(ctx context.Context, userID int64, consignmentIDs []string, at time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveOrders", ctx, userID, consignmentIDs, at)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveOrders indicates an expected call of ArchiveOrders.
+func (mr *MockOrderRepositoryPortMockRecorder) ArchiveOrders(ctx, userID, consignmentIDs, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ArchiveOrders), ctx, userID, consignmentIDs, at)
+}
+
+// CountOrders mocks base method.
+func (m *MockOrderRepositoryPort) CountOrders(ctx context.Context, userID int64, filter domain.OrderFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrders", ctx, userID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrders indicates an expected call of CountOrders.
+func (mr *MockOrderRepositoryPortMockRecorder) CountOrders(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CountOrders), ctx, userID, filter)
+}
+
+// CountPromotionRedemptions mocks base method.
+func (m *MockOrderRepositoryPort) CountPromotionRedemptions(ctx context.Context, promotionID, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPromotionRedemptions", ctx, promotionID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPromotionRedemptions indicates an expected call of CountPromotionRedemptions.
+func (mr *MockOrderRepositoryPortMockRecorder) CountPromotionRedemptions(ctx, promotionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPromotionRedemptions", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CountPromotionRedemptions), ctx, promotionID, userID)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockOrderRepositoryPort) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateAPIKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateAPIKey), ctx, key)
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockOrderRepositoryPort) CreateEmailVerification(ctx context.Context, v *domain.EmailVerification) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelOrder", ctx, consignmentID, userID)
+	ret := m.ctrl.Call(m, "CreateEmailVerification", ctx, v)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CancelOrder indicates an expected call of CancelOrder.
-func (mr *MockOrderRepositoryPortMockRecorder) CancelOrder(ctx, consignmentID, userID interface{}) *gomock.Call {
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateEmailVerification(ctx, v interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CancelOrder), ctx, consignmentID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateEmailVerification), ctx, v)
 }
 
 // CreateOrder mocks base method.
@@ -131,6 +221,118 @@ func (mr *MockOrderRepositoryPortMockRecorder) CreateOrder(ctx, order interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateOrder), ctx, order)
 }
 
+// CreateOrderWithIdempotencyKey mocks base method.
+func (m *MockOrderRepositoryPort) CreateOrderWithIdempotencyKey(ctx context.Context, order *domain.Order, key *domain.IdempotencyKey, expiredBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderWithIdempotencyKey", ctx, order, key, expiredBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrderWithIdempotencyKey indicates an expected call of CreateOrderWithIdempotencyKey.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateOrderWithIdempotencyKey(ctx, order, key, expiredBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderWithIdempotencyKey", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateOrderWithIdempotencyKey), ctx, order, key, expiredBefore)
+}
+
+// CreateOrders mocks base method.
+func (m *MockOrderRepositoryPort) CreateOrders(ctx context.Context, orders []*domain.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrders", ctx, orders)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrders indicates an expected call of CreateOrders.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateOrders(ctx, orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateOrders), ctx, orders)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockOrderRepositoryPort) CreatePasswordReset(ctx context.Context, reset *domain.PasswordReset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, reset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockOrderRepositoryPortMockRecorder) CreatePasswordReset(ctx, reset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreatePasswordReset), ctx, reset)
+}
+
+// CreatePromotion mocks base method.
+func (m *MockOrderRepositoryPort) CreatePromotion(ctx context.Context, promo *domain.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockOrderRepositoryPortMockRecorder) CreatePromotion(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreatePromotion), ctx, promo)
+}
+
+// CreateRateCard mocks base method.
+func (m *MockOrderRepositoryPort) CreateRateCard(ctx context.Context, card *domain.RateCard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRateCard", ctx, card)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRateCard indicates an expected call of CreateRateCard.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateRateCard(ctx, card interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRateCard", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateRateCard), ctx, card)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockOrderRepositoryPort) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateRefreshToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateRefreshToken), ctx, token)
+}
+
+// CreateSession mocks base method.
+func (m *MockOrderRepositoryPort) CreateSession(ctx context.Context, session *domain.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateSession(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateSession), ctx, session)
+}
+
+// CreateStore mocks base method.
+func (m *MockOrderRepositoryPort) CreateStore(ctx context.Context, store *domain.Store) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStore", ctx, store)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStore indicates an expected call of CreateStore.
+func (mr *MockOrderRepositoryPortMockRecorder) CreateStore(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStore", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateStore), ctx, store)
+}
+
 // CreateUser mocks base method.
 func (m *MockOrderRepositoryPort) CreateUser(ctx context.Context, username, password string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +348,199 @@ func (mr *MockOrderRepositoryPortMockRecorder) CreateUser(ctx, username, passwor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockOrderRepositoryPort)(nil).CreateUser), ctx, username, password)
 }
 
+// DeleteIdempotencyKeys mocks base method.
+func (m *MockOrderRepositoryPort) DeleteIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyKeys", ctx, expiredBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIdempotencyKeys indicates an expected call of DeleteIdempotencyKeys.
+func (mr *MockOrderRepositoryPortMockRecorder) DeleteIdempotencyKeys(ctx, expiredBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyKeys", reflect.TypeOf((*MockOrderRepositoryPort)(nil).DeleteIdempotencyKeys), ctx, expiredBefore)
+}
+
+// DeleteTwoFactor mocks base method.
+func (m *MockOrderRepositoryPort) DeleteTwoFactor(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTwoFactor indicates an expected call of DeleteTwoFactor.
+func (mr *MockOrderRepositoryPortMockRecorder) DeleteTwoFactor(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTwoFactor", reflect.TypeOf((*MockOrderRepositoryPort)(nil).DeleteTwoFactor), ctx, userID)
+}
+
+// EnableTwoFactor mocks base method.
+func (m *MockOrderRepositoryPort) EnableTwoFactor(ctx context.Context, userID, step int64, recoveryCodeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", ctx, userID, step, recoveryCodeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockOrderRepositoryPortMockRecorder) EnableTwoFactor(ctx, userID, step, recoveryCodeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockOrderRepositoryPort)(nil).EnableTwoFactor), ctx, userID, step, recoveryCodeHashes)
+}
+
+// FindAPIKeyByPrefix mocks base method.
+func (m *MockOrderRepositoryPort) FindAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKeyByPrefix", ctx, prefix)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKeyByPrefix indicates an expected call of FindAPIKeyByPrefix.
+func (mr *MockOrderRepositoryPortMockRecorder) FindAPIKeyByPrefix(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKeyByPrefix", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindAPIKeyByPrefix), ctx, prefix)
+}
+
+// FindEmailVerification mocks base method.
+func (m *MockOrderRepositoryPort) FindEmailVerification(ctx context.Context, userID int64) (*domain.EmailVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEmailVerification", ctx, userID)
+	ret0, _ := ret[0].(*domain.EmailVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEmailVerification indicates an expected call of FindEmailVerification.
+func (mr *MockOrderRepositoryPortMockRecorder) FindEmailVerification(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEmailVerification", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindEmailVerification), ctx, userID)
+}
+
+// FindIdempotencyKey mocks base method.
+func (m *MockOrderRepositoryPort) FindIdempotencyKey(ctx context.Context, userID int64, key string, expiredBefore time.Time) (*domain.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdempotencyKey", ctx, userID, key, expiredBefore)
+	ret0, _ := ret[0].(*domain.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdempotencyKey indicates an expected call of FindIdempotencyKey.
+func (mr *MockOrderRepositoryPortMockRecorder) FindIdempotencyKey(ctx, userID, key, expiredBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdempotencyKey", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindIdempotencyKey), ctx, userID, key, expiredBefore)
+}
+
+// FindOrder mocks base method.
+func (m *MockOrderRepositoryPort) FindOrder(ctx context.Context, consignmentID string) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrder", ctx, consignmentID)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrder indicates an expected call of FindOrder.
+func (mr *MockOrderRepositoryPortMockRecorder) FindOrder(ctx, consignmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrder", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindOrder), ctx, consignmentID)
+}
+
+// FindPasswordReset mocks base method.
+func (m *MockOrderRepositoryPort) FindPasswordReset(ctx context.Context, tokenHash string) (*domain.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPasswordReset", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPasswordReset indicates an expected call of FindPasswordReset.
+func (mr *MockOrderRepositoryPortMockRecorder) FindPasswordReset(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPasswordReset", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindPasswordReset), ctx, tokenHash)
+}
+
+// FindPromotionByCode mocks base method.
+func (m *MockOrderRepositoryPort) FindPromotionByCode(ctx context.Context, code string) (*domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPromotionByCode", ctx, code)
+	ret0, _ := ret[0].(*domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPromotionByCode indicates an expected call of FindPromotionByCode.
+func (mr *MockOrderRepositoryPortMockRecorder) FindPromotionByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPromotionByCode", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindPromotionByCode), ctx, code)
+}
+
+// FindRefreshToken mocks base method.
+func (m *MockOrderRepositoryPort) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRefreshToken", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRefreshToken indicates an expected call of FindRefreshToken.
+func (mr *MockOrderRepositoryPortMockRecorder) FindRefreshToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRefreshToken", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindRefreshToken), ctx, tokenHash)
+}
+
+// FindStore mocks base method.
+func (m *MockOrderRepositoryPort) FindStore(ctx context.Context, id int64) (*domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStore", ctx, id)
+	ret0, _ := ret[0].(*domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStore indicates an expected call of FindStore.
+func (mr *MockOrderRepositoryPortMockRecorder) FindStore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStore", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindStore), ctx, id)
+}
+
+// FindTwoFactor mocks base method.
+func (m *MockOrderRepositoryPort) FindTwoFactor(ctx context.Context, userID int64) (*domain.TwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(*domain.TwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTwoFactor indicates an expected call of FindTwoFactor.
+func (mr *MockOrderRepositoryPortMockRecorder) FindTwoFactor(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTwoFactor", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindTwoFactor), ctx, userID)
+}
+
+// FindUserByID mocks base method.
+func (m *MockOrderRepositoryPort) FindUserByID(ctx context.Context, userID int64) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserByID", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserByID indicates an expected call of FindUserByID.
+func (mr *MockOrderRepositoryPortMockRecorder) FindUserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserByID", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindUserByID), ctx, userID)
+}
+
 // FindUserByUsername mocks base method.
 func (m *MockOrderRepositoryPort) FindUserByUsername(ctx context.Context, username string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -161,18 +556,784 @@ func (mr *MockOrderRepositoryPortMockRecorder) FindUserByUsername(ctx, username
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserByUsername", reflect.TypeOf((*MockOrderRepositoryPort)(nil).FindUserByUsername), ctx, username)
 }
 
-// ListOrders mocks base method.
-func (m *MockOrderRepositoryPort) ListOrders(ctx context.Context, userID, limit, page int64) ([]*domain.Order, int64, error) {
+// InvalidateEmailVerifications mocks base method.
+func (m *MockOrderRepositoryPort) InvalidateEmailVerifications(ctx context.Context, userID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrders", ctx, userID, limit, page)
-	ret0, _ := ret[0].([]*domain.Order)
-	ret1, _ := ret[1].(int64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "InvalidateEmailVerifications", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListOrders indicates an expected call of ListOrders.
-func (mr *MockOrderRepositoryPortMockRecorder) ListOrders(ctx, userID, limit, page interface{}) *gomock.Call {
+// InvalidateEmailVerifications indicates an expected call of InvalidateEmailVerifications.
+func (mr *MockOrderRepositoryPortMockRecorder) InvalidateEmailVerifications(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateEmailVerifications", reflect.TypeOf((*MockOrderRepositoryPort)(nil).InvalidateEmailVerifications), ctx, userID)
+}
+
+// InvalidatePasswordResets mocks base method.
+func (m *MockOrderRepositoryPort) InvalidatePasswordResets(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidatePasswordResets", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidatePasswordResets indicates an expected call of InvalidatePasswordResets.
+func (mr *MockOrderRepositoryPortMockRecorder) InvalidatePasswordResets(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePasswordResets", reflect.TypeOf((*MockOrderRepositoryPort)(nil).InvalidatePasswordResets), ctx, userID)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockOrderRepositoryPort) ListAPIKeys(ctx context.Context, userID int64) ([]*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockOrderRepositoryPortMockRecorder) ListAPIKeys(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListAPIKeys), ctx, userID)
+}
+
+// ListLocations mocks base method.
+func (m *MockOrderRepositoryPort) ListLocations(ctx context.Context) (*domain.LocationCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", ctx)
+	ret0, _ := ret[0].(*domain.LocationCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockOrderRepositoryPortMockRecorder) ListLocations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListLocations), ctx)
+}
+
+// ListOrderStatusHistory mocks base method.
+func (m *MockOrderRepositoryPort) ListOrderStatusHistory(ctx context.Context, consignmentID string) ([]*domain.OrderStatusEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrderStatusHistory", ctx, consignmentID)
+	ret0, _ := ret[0].([]*domain.OrderStatusEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrderStatusHistory indicates an expected call of ListOrderStatusHistory.
+func (mr *MockOrderRepositoryPortMockRecorder) ListOrderStatusHistory(ctx, consignmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrderStatusHistory", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListOrderStatusHistory), ctx, consignmentID)
+}
+
+// ListOrders mocks base method.
+func (m *MockOrderRepositoryPort) ListOrders(ctx context.Context, userID int64, filter domain.OrderFilter, limit, page int64) ([]*domain.Order, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", ctx, userID, filter, limit, page)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockOrderRepositoryPortMockRecorder) ListOrders(ctx, userID, filter, limit, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListOrders), ctx, userID, filter, limit, page)
+}
+
+// ListOrdersAfter mocks base method.
+func (m *MockOrderRepositoryPort) ListOrdersAfter(ctx context.Context, userID int64, filter domain.OrderFilter, after *domain.OrderCursor, limit int64) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrdersAfter", ctx, userID, filter, after, limit)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrdersAfter indicates an expected call of ListOrdersAfter.
+func (mr *MockOrderRepositoryPortMockRecorder) ListOrdersAfter(ctx, userID, filter, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrdersAfter", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListOrdersAfter), ctx, userID, filter, after, limit)
+}
+
+// ListPromotions mocks base method.
+func (m *MockOrderRepositoryPort) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromotions", ctx)
+	ret0, _ := ret[0].([]*domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromotions indicates an expected call of ListPromotions.
+func (mr *MockOrderRepositoryPortMockRecorder) ListPromotions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotions", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListPromotions), ctx)
+}
+
+// ListRateCards mocks base method.
+func (m *MockOrderRepositoryPort) ListRateCards(ctx context.Context) ([]*domain.RateCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRateCards", ctx)
+	ret0, _ := ret[0].([]*domain.RateCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRateCards indicates an expected call of ListRateCards.
+func (mr *MockOrderRepositoryPortMockRecorder) ListRateCards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRateCards", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListRateCards), ctx)
+}
+
+// ListSessions mocks base method.
+func (m *MockOrderRepositoryPort) ListSessions(ctx context.Context, userID int64) ([]*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockOrderRepositoryPortMockRecorder) ListSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListSessions), ctx, userID)
+}
+
+// ListStores mocks base method.
+func (m *MockOrderRepositoryPort) ListStores(ctx context.Context, userID int64) ([]*domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStores", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStores indicates an expected call of ListStores.
+func (mr *MockOrderRepositoryPortMockRecorder) ListStores(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStores", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListStores), ctx, userID)
+}
+
+// MarkEmailVerified mocks base method.
+func (m *MockOrderRepositoryPort) MarkEmailVerified(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockOrderRepositoryPortMockRecorder) MarkEmailVerified(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockOrderRepositoryPort)(nil).MarkEmailVerified), ctx, userID)
+}
+
+// RecordEmailVerificationAttempt mocks base method.
+func (m *MockOrderRepositoryPort) RecordEmailVerificationAttempt(ctx context.Context, id int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEmailVerificationAttempt", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordEmailVerificationAttempt indicates an expected call of RecordEmailVerificationAttempt.
+func (mr *MockOrderRepositoryPortMockRecorder) RecordEmailVerificationAttempt(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEmailVerificationAttempt", reflect.TypeOf((*MockOrderRepositoryPort)(nil).RecordEmailVerificationAttempt), ctx, id)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockOrderRepositoryPort) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", ctx, userID, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockOrderRepositoryPortMockRecorder) ReplaceRecoveryCodes(ctx, userID, codeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ReplaceRecoveryCodes), ctx, userID, codeHashes)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockOrderRepositoryPort) RevokeAPIKey(ctx context.Context, id, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockOrderRepositoryPortMockRecorder) RevokeAPIKey(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockOrderRepositoryPort)(nil).RevokeAPIKey), ctx, id, userID)
+}
+
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockOrderRepositoryPort) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockOrderRepositoryPortMockRecorder) RevokeRefreshTokenFamily(ctx, familyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockOrderRepositoryPort)(nil).RevokeRefreshTokenFamily), ctx, familyID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockOrderRepositoryPort) RevokeSession(ctx context.Context, id string, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockOrderRepositoryPortMockRecorder) RevokeSession(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockOrderRepositoryPort)(nil).RevokeSession), ctx, id, userID)
+}
+
+// RevokeUserRefreshTokens mocks base method.
+func (m *MockOrderRepositoryPort) RevokeUserRefreshTokens(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserRefreshTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserRefreshTokens indicates an expected call of RevokeUserRefreshTokens.
+func (mr *MockOrderRepositoryPortMockRecorder) RevokeUserRefreshTokens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserRefreshTokens", reflect.TypeOf((*MockOrderRepositoryPort)(nil).RevokeUserRefreshTokens), ctx, userID)
+}
+
+// SaveLocations mocks base method.
+func (m *MockOrderRepositoryPort) SaveLocations(ctx context.Context, catalog *domain.LocationCatalog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLocations", ctx, catalog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLocations indicates an expected call of SaveLocations.
+func (mr *MockOrderRepositoryPortMockRecorder) SaveLocations(ctx, catalog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLocations", reflect.TypeOf((*MockOrderRepositoryPort)(nil).SaveLocations), ctx, catalog)
+}
+
+// SaveTwoFactor mocks base method.
+func (m *MockOrderRepositoryPort) SaveTwoFactor(ctx context.Context, tf *domain.TwoFactor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTwoFactor", ctx, tf)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTwoFactor indicates an expected call of SaveTwoFactor.
+func (mr *MockOrderRepositoryPortMockRecorder) SaveTwoFactor(ctx, tf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTwoFactor", reflect.TypeOf((*MockOrderRepositoryPort)(nil).SaveTwoFactor), ctx, tf)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockOrderRepositoryPort) TouchAPIKey(ctx context.Context, id int64, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, id, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockOrderRepositoryPortMockRecorder) TouchAPIKey(ctx, id, usedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockOrderRepositoryPort)(nil).TouchAPIKey), ctx, id, usedAt)
+}
+
+// TouchSession mocks base method.
+func (m *MockOrderRepositoryPort) TouchSession(ctx context.Context, id, ip string, seenAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, id, ip, seenAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockOrderRepositoryPortMockRecorder) TouchSession(ctx, id, ip, seenAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockOrderRepositoryPort)(nil).TouchSession), ctx, id, ip, seenAt)
+}
+
+// UnarchiveOrders mocks base method.
+func (m *MockOrderRepositoryPort) UnarchiveOrders(ctx context.Context, userID int64, consignmentIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveOrders", ctx, userID, consignmentIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveOrders indicates an expected call of UnarchiveOrders.
+func (mr *MockOrderRepositoryPortMockRecorder) UnarchiveOrders(ctx, userID, consignmentIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UnarchiveOrders), ctx, userID, consignmentIDs)
+}
+
+// UpdateOrder mocks base method.
+func (m *MockOrderRepositoryPort) UpdateOrder(ctx context.Context, order *domain.Order, event *domain.OrderStatusEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrder", ctx, order, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrder indicates an expected call of UpdateOrder.
+func (mr *MockOrderRepositoryPortMockRecorder) UpdateOrder(ctx, order, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UpdateOrder), ctx, order, event)
+}
+
+// UpdateOrderStatus mocks base method.
+func (m *MockOrderRepositoryPort) UpdateOrderStatus(ctx context.Context, event *domain.OrderStatusEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
+func (mr *MockOrderRepositoryPortMockRecorder) UpdateOrderStatus(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UpdateOrderStatus), ctx, event)
+}
+
+// UpdateStore mocks base method.
+func (m *MockOrderRepositoryPort) UpdateStore(ctx context.Context, store *domain.Store) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStore", ctx, store)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStore indicates an expected call of UpdateStore.
+func (mr *MockOrderRepositoryPortMockRecorder) UpdateStore(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStore", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UpdateStore), ctx, store)
+}
+
+// UpdateUserDiscount mocks base method.
+func (m *MockOrderRepositoryPort) UpdateUserDiscount(ctx context.Context, userID int64, percent float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDiscount", ctx, userID, percent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDiscount indicates an expected call of UpdateUserDiscount.
+func (mr *MockOrderRepositoryPortMockRecorder) UpdateUserDiscount(ctx, userID, percent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDiscount", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UpdateUserDiscount), ctx, userID, percent)
+}
+
+// UpdateUserPassword mocks base method.
+func (m *MockOrderRepositoryPort) UpdateUserPassword(ctx context.Context, userID int64, hashedPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPassword", ctx, userID, hashedPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPassword indicates an expected call of UpdateUserPassword.
+func (mr *MockOrderRepositoryPortMockRecorder) UpdateUserPassword(ctx, userID, hashedPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UpdateUserPassword), ctx, userID, hashedPassword)
+}
+
+// UpdateUserRoles mocks base method.
+func (m *MockOrderRepositoryPort) UpdateUserRoles(ctx context.Context, userID int64, roles []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRoles", ctx, userID, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserRoles indicates an expected call of UpdateUserRoles.
+func (mr *MockOrderRepositoryPortMockRecorder) UpdateUserRoles(ctx, userID, roles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRoles", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UpdateUserRoles), ctx, userID, roles)
+}
+
+// UseEmailVerification mocks base method.
+func (m *MockOrderRepositoryPort) UseEmailVerification(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseEmailVerification", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseEmailVerification indicates an expected call of UseEmailVerification.
+func (mr *MockOrderRepositoryPortMockRecorder) UseEmailVerification(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseEmailVerification", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UseEmailVerification), ctx, id)
+}
+
+// UsePasswordReset mocks base method.
+func (m *MockOrderRepositoryPort) UsePasswordReset(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePasswordReset", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UsePasswordReset indicates an expected call of UsePasswordReset.
+func (mr *MockOrderRepositoryPortMockRecorder) UsePasswordReset(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePasswordReset", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UsePasswordReset), ctx, id)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockOrderRepositoryPort) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, codeHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockOrderRepositoryPortMockRecorder) UseRecoveryCode(ctx, userID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UseRecoveryCode), ctx, userID, codeHash)
+}
+
+// UseRefreshToken mocks base method.
+func (m *MockOrderRepositoryPort) UseRefreshToken(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken.
+func (mr *MockOrderRepositoryPortMockRecorder) UseRefreshToken(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UseRefreshToken), ctx, id)
+}
+
+// UseTwoFactorStep mocks base method.
+func (m *MockOrderRepositoryPort) UseTwoFactorStep(ctx context.Context, userID, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTwoFactorStep", ctx, userID, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseTwoFactorStep indicates an expected call of UseTwoFactorStep.
+func (mr *MockOrderRepositoryPortMockRecorder) UseTwoFactorStep(ctx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTwoFactorStep", reflect.TypeOf((*MockOrderRepositoryPort)(nil).UseTwoFactorStep), ctx, userID, step)
+}
+
+// MockCachePort is a mock of CachePort interface.
+type MockCachePort struct {
+	ctrl     *gomock.Controller
+	recorder *MockCachePortMockRecorder
+}
+
+// MockCachePortMockRecorder is the mock recorder for MockCachePort.
+type MockCachePortMockRecorder struct {
+	mock *MockCachePort
+}
+
+// NewMockCachePort creates a new mock instance.
+func NewMockCachePort(ctrl *gomock.Controller) *MockCachePort {
+	mock := &MockCachePort{ctrl: ctrl}
+	mock.recorder = &MockCachePortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCachePort) EXPECT() *MockCachePortMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCachePort) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCachePortMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCachePort)(nil).Delete), ctx, key)
+}
+
+// DeleteByPrefix mocks base method.
+func (m *MockCachePort) DeleteByPrefix(ctx context.Context, prefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByPrefix", ctx, prefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByPrefix indicates an expected call of DeleteByPrefix.
+func (mr *MockCachePortMockRecorder) DeleteByPrefix(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPrefix", reflect.TypeOf((*MockCachePort)(nil).DeleteByPrefix), ctx, prefix)
+}
+
+// Exists mocks base method.
+func (m *MockCachePort) Exists(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockCachePortMockRecorder) Exists(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockCachePort)(nil).Exists), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockCachePort) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCachePortMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCachePort)(nil).Get), ctx, key)
+}
+
+// Increment mocks base method.
+func (m *MockCachePort) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, key, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCachePortMockRecorder) Increment(ctx, key, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCachePort)(nil).Increment), ctx, key, ttl)
+}
+
+// Ping mocks base method.
+func (m *MockCachePort) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockCachePortMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCachePort)(nil).Ping), ctx)
+}
+
+// Set mocks base method.
+func (m *MockCachePort) Set(ctx context.Context, key string, value interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockCachePortMockRecorder) Set(ctx, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCachePort)(nil).Set), ctx, key, value)
+}
+
+// SetWithTTL mocks base method.
+func (m *MockCachePort) SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWithTTL", ctx, key, value, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWithTTL indicates an expected call of SetWithTTL.
+func (mr *MockCachePortMockRecorder) SetWithTTL(ctx, key, value, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithTTL", reflect.TypeOf((*MockCachePort)(nil).SetWithTTL), ctx, key, value, ttl)
+}
+
+// MockRevocationStorePort is a mock of RevocationStorePort interface.
+type MockRevocationStorePort struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationStorePortMockRecorder
+}
+
+// MockRevocationStorePortMockRecorder is the mock recorder for MockRevocationStorePort.
+type MockRevocationStorePortMockRecorder struct {
+	mock *MockRevocationStorePort
+}
+
+// NewMockRevocationStorePort creates a new mock instance.
+func NewMockRevocationStorePort(ctrl *gomock.Controller) *MockRevocationStorePort {
+	mock := &MockRevocationStorePort{ctrl: ctrl}
+	mock.recorder = &MockRevocationStorePortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationStorePort) EXPECT() *MockRevocationStorePortMockRecorder {
+	return m.recorder
+}
+
+// IsRevoked mocks base method.
+func (m *MockRevocationStorePort) IsRevoked(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockRevocationStorePortMockRecorder) IsRevoked(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockRevocationStorePort)(nil).IsRevoked), ctx, id)
+}
+
+// Revoke mocks base method.
+func (m *MockRevocationStorePort) Revoke(ctx context.Context, id string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRevocationStorePortMockRecorder) Revoke(ctx, id, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRevocationStorePort)(nil).Revoke), ctx, id, until)
+}
+
+// RevokeUser mocks base method.
+func (m *MockRevocationStorePort) RevokeUser(ctx context.Context, userID int64, issuedBefore, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUser", ctx, userID, issuedBefore, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUser indicates an expected call of RevokeUser.
+func (mr *MockRevocationStorePortMockRecorder) RevokeUser(ctx, userID, issuedBefore, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUser", reflect.TypeOf((*MockRevocationStorePort)(nil).RevokeUser), ctx, userID, issuedBefore, until)
+}
+
+// UserRevokedBefore mocks base method.
+func (m *MockRevocationStorePort) UserRevokedBefore(ctx context.Context, userID int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserRevokedBefore", ctx, userID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserRevokedBefore indicates an expected call of UserRevokedBefore.
+func (mr *MockRevocationStorePortMockRecorder) UserRevokedBefore(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRevokedBefore", reflect.TypeOf((*MockRevocationStorePort)(nil).UserRevokedBefore), ctx, userID)
+}
+
+// MockIDGeneratorPort is a mock of IDGeneratorPort interface.
+type MockIDGeneratorPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDGeneratorPortMockRecorder
+}
+
+// MockIDGeneratorPortMockRecorder is the mock recorder for MockIDGeneratorPort.
+type MockIDGeneratorPortMockRecorder struct {
+	mock *MockIDGeneratorPort
+}
+
+// NewMockIDGeneratorPort creates a new mock instance.
+func NewMockIDGeneratorPort(ctrl *gomock.Controller) *MockIDGeneratorPort {
+	mock := &MockIDGeneratorPort{ctrl: ctrl}
+	mock.recorder = &MockIDGeneratorPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDGeneratorPort) EXPECT() *MockIDGeneratorPortMockRecorder {
+	return m.recorder
+}
+
+// NewConsignmentID mocks base method.
+func (m *MockIDGeneratorPort) NewConsignmentID(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConsignmentID", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewConsignmentID indicates an expected call of NewConsignmentID.
+func (mr *MockIDGeneratorPortMockRecorder) NewConsignmentID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConsignmentID", reflect.TypeOf((*MockIDGeneratorPort)(nil).NewConsignmentID), ctx)
+}
+
+// MockNotifierPort is a mock of NotifierPort interface.
+type MockNotifierPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierPortMockRecorder
+}
+
+// MockNotifierPortMockRecorder is the mock recorder for MockNotifierPort.
+type MockNotifierPortMockRecorder struct {
+	mock *MockNotifierPort
+}
+
+// NewMockNotifierPort creates a new mock instance.
+func NewMockNotifierPort(ctrl *gomock.Controller) *MockNotifierPort {
+	mock := &MockNotifierPort{ctrl: ctrl}
+	mock.recorder = &MockNotifierPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifierPort) EXPECT() *MockNotifierPortMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifierPort) Notify(ctx context.Context, n domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierPortMockRecorder) Notify(ctx, n interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockOrderRepositoryPort)(nil).ListOrders), ctx, userID, limit, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifierPort)(nil).Notify), ctx, n)
 }
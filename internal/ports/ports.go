@@ -3,25 +3,113 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"github.com/mahabubulhasibshawon/grpc-ecommerce.git/internal/domain"
 )
 
 type AuthPort interface {
 	Signup(ctx context.Context, username, password string) (*domain.User, error)
-	Login(ctx context.Context, username, password string) (string, *domain.User, error)
+	Login(ctx context.Context, username, password string, client domain.ClientInfo) (*domain.TokenPair, *domain.User, error)
+	RefreshToken(ctx context.Context, refreshToken string, client domain.ClientInfo) (*domain.TokenPair, error)
 	Logout(ctx context.Context, userID int64) error
 }
 
 type OrderRepositoryPort interface {
 	CreateUser(ctx context.Context, username, password string) (*domain.User, error)
 	FindUserByUsername(ctx context.Context, username string) (*domain.User, error)
+	FindUserByID(ctx context.Context, userID int64) (*domain.User, error)
+	UpdateUserRoles(ctx context.Context, userID int64, roles []string) error
+	UpdateUserPassword(ctx context.Context, userID int64, hashedPassword string) error
+	CreatePasswordReset(ctx context.Context, reset *domain.PasswordReset) error
+	FindPasswordReset(ctx context.Context, tokenHash string) (*domain.PasswordReset, error)
+	UsePasswordReset(ctx context.Context, id int64) error
+	InvalidatePasswordResets(ctx context.Context, userID int64) error
+	MarkEmailVerified(ctx context.Context, userID int64) error
+	CreateEmailVerification(ctx context.Context, v *domain.EmailVerification) error
+	FindEmailVerification(ctx context.Context, userID int64) (*domain.EmailVerification, error)
+	RecordEmailVerificationAttempt(ctx context.Context, id int64) (int64, error)
+	UseEmailVerification(ctx context.Context, id int64) error
+	InvalidateEmailVerifications(ctx context.Context, userID int64) error
+	CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error
+	FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	UseRefreshToken(ctx context.Context, id int64) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int64) error
+	CreateSession(ctx context.Context, session *domain.Session) error
+	ListSessions(ctx context.Context, userID int64) ([]*domain.Session, error)
+	TouchSession(ctx context.Context, id, ip string, seenAt time.Time) error
+	RevokeSession(ctx context.Context, id string, userID int64) error
+	FindTwoFactor(ctx context.Context, userID int64) (*domain.TwoFactor, error)
+	SaveTwoFactor(ctx context.Context, tf *domain.TwoFactor) error
+	EnableTwoFactor(ctx context.Context, userID, step int64, recoveryCodeHashes []string) error
+	UseTwoFactorStep(ctx context.Context, userID, step int64) error
+	DeleteTwoFactor(ctx context.Context, userID int64) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	CreateAPIKey(ctx context.Context, key *domain.APIKey) error
+	FindAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+	ListAPIKeys(ctx context.Context, userID int64) ([]*domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id, userID int64) error
+	TouchAPIKey(ctx context.Context, id int64, usedAt time.Time) error
 	CreateOrder(ctx context.Context, order *domain.Order) error
-	ListOrders(ctx context.Context, userID int64, limit, page int64) ([]*domain.Order, int64, error)
-	CancelOrder(ctx context.Context, consignmentID string, userID int64) error
+	CreateOrders(ctx context.Context, orders []*domain.Order) error
+	CreateOrderWithIdempotencyKey(ctx context.Context, order *domain.Order, key *domain.IdempotencyKey, expiredBefore time.Time) error
+	FindIdempotencyKey(ctx context.Context, userID int64, key string, expiredBefore time.Time) (*domain.IdempotencyKey, error)
+	DeleteIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int64, error)
+	ListOrders(ctx context.Context, userID int64, filter domain.OrderFilter, limit, page int64) ([]*domain.Order, int64, error)
+	ListOrdersAfter(ctx context.Context, userID int64, filter domain.OrderFilter, after *domain.OrderCursor, limit int64) ([]*domain.Order, error)
+	CountOrders(ctx context.Context, userID int64, filter domain.OrderFilter) (int64, error)
+	ArchiveOrders(ctx context.Context, userID int64, consignmentIDs []string, at time.Time) ([]string, error)
+	UnarchiveOrders(ctx context.Context, userID int64, consignmentIDs []string) ([]string, error)
+	ArchiveFinalOrders(ctx context.Context, finalBefore, at time.Time) ([]int64, error)
+	FindOrder(ctx context.Context, consignmentID string) (*domain.Order, error)
+	UpdateOrderStatus(ctx context.Context, event *domain.OrderStatusEvent) error
+	UpdateOrder(ctx context.Context, order *domain.Order, event *domain.OrderStatusEvent) error
+	ListOrderStatusHistory(ctx context.Context, consignmentID string) ([]*domain.OrderStatusEvent, error)
+	CreateRateCard(ctx context.Context, card *domain.RateCard) error
+	ListRateCards(ctx context.Context) ([]*domain.RateCard, error)
+	UpdateUserDiscount(ctx context.Context, userID int64, percent float64) error
+	CreatePromotion(ctx context.Context, promo *domain.Promotion) error
+	ListPromotions(ctx context.Context) ([]*domain.Promotion, error)
+	FindPromotionByCode(ctx context.Context, code string) (*domain.Promotion, error)
+	CountPromotionRedemptions(ctx context.Context, promotionID, userID int64) (int64, error)
+	CreateStore(ctx context.Context, store *domain.Store) error
+	UpdateStore(ctx context.Context, store *domain.Store) error
+	FindStore(ctx context.Context, id int64) (*domain.Store, error)
+	ListStores(ctx context.Context, userID int64) ([]*domain.Store, error)
+	SaveLocations(ctx context.Context, catalog *domain.LocationCatalog) error
+	ListLocations(ctx context.Context) (*domain.LocationCatalog, error)
 }
 type CachePort interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	Set(ctx context.Context, key string, value interface{}) error
+	SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+	Exists(ctx context.Context, key string) (bool, error)
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	Delete(ctx context.Context, key string) error
 	DeleteByPrefix(ctx context.Context, prefix string) error
 	Ping(ctx context.Context) error
-}
\ No newline at end of file
+}
+
+// RevocationStorePort records revoked token identifiers (JWT jti claims) until
+// the tokens they belong to would have expired anyway. RevokeUser invalidates
+// every token of a user issued up to issuedBefore.
+type RevocationStorePort interface {
+	Revoke(ctx context.Context, id string, until time.Time) error
+	IsRevoked(ctx context.Context, id string) (bool, error)
+	RevokeUser(ctx context.Context, userID int64, issuedBefore, until time.Time) error
+	UserRevokedBefore(ctx context.Context, userID int64) (time.Time, error)
+}
+
+// IDGeneratorPort issues consignment IDs for new orders. IDs are unique and
+// carry a check digit, see domain.ValidConsignmentID.
+type IDGeneratorPort interface {
+	NewConsignmentID(ctx context.Context) (string, error)
+}
+
+// NotifierPort delivers messages such as password reset tokens and email
+// verification codes to users.
+type NotifierPort interface {
+	Notify(ctx context.Context, n domain.Notification) error
+}
@@ -0,0 +1,21 @@
+// pkg/auth/hash_test.go
+package auth
+
+import "testing"
+
+func TestHashVerificationCode(t *testing.T) {
+	defer SetCodeHashKey(newCodeKey())
+
+	SetCodeHashKey([]byte("first key of at least thirty-two bytes"))
+	first := HashVerificationCode("123456")
+	if first != HashVerificationCode("123456") {
+		t.Errorf("HashVerificationCode() is not stable under one key")
+	}
+	if first == hashToken("123456") {
+		t.Errorf("HashVerificationCode() = plain SHA-256 digest, want a keyed hash")
+	}
+	SetCodeHashKey([]byte("second key of at least thirty-two bytes"))
+	if HashVerificationCode("123456") == first {
+		t.Errorf("HashVerificationCode() does not depend on the key")
+	}
+}
@@ -2,52 +2,211 @@
 package auth
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const apiKeyScheme = "gek_"
+
 var (
-	secret       = []byte("your-secret-key")
-	blacklist    = &sync.Map{}
-	blacklistTTL = 5 * time.Minute
+	keysMu sync.RWMutex
+	keys   = NewEphemeralKeySet()
+
+	// AccessTokenTTL is the lifetime of the JWT access tokens handed to clients.
+	AccessTokenTTL = 15 * time.Minute
+	// RefreshTokenTTL is the lifetime of the opaque refresh tokens stored server-side.
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	// ChallengeTokenTTL is how long a user has to enter their second factor
+	// after the password was accepted.
+	ChallengeTokenTTL = 5 * time.Minute
 )
 
+// challengePurpose marks tokens that only prove the password step of a two
+// factor login.
+const challengePurpose = "2fa"
+
+func init() {
+	// Tokens issued right after a user's tokens were revoked, such as on the
+	// login that follows a password reset, must be told apart from the revoked
+	// ones, so iat and exp carry milliseconds
+	jwt.TimePrecision = time.Millisecond
+}
+
+// SetKeySet replaces the keys used to sign and verify tokens.
+func SetKeySet(ks *KeySet) {
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	keys = ks
+}
+
+func activeKeys() *KeySet {
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+	return keys
+}
+
 type Claims struct {
-	Username string `json:"username"`
-	UserID   int64  `json:"user_id"`
+	Username  string   `json:"username"`
+	UserID    int64    `json:"user_id"`
+	Roles     []string `json:"roles,omitempty"`
+	SessionID string   `json:"sid,omitempty"`
+	Purpose   string   `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(username string, userID int64) (string, error) {
+// GenerateToken issues an access token. sessionID ties the token to a login
+// session so it can be revoked with it; it may be empty.
+func GenerateToken(username string, userID int64, roles []string, sessionID string) (string, error) {
+	jti, err := RandomString(16)
+	if err != nil {
+		return "", err
+	}
+	claims := Claims{
+		Username:  username,
+		UserID:    userID,
+		Roles:     roles,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return activeKeys().sign(claims)
+}
+
+// ValidateToken parses an access token. Challenge tokens are rejected.
+func ValidateToken(tokenStr string) (*Claims, error) {
+	claims, err := parseToken(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != "" {
+		return nil, errors.New("not an access token")
+	}
+	return claims, nil
+}
+
+// GenerateChallengeToken issues a token that lets the user finish a two factor
+// login within ChallengeTokenTTL. It cannot be used as an access token.
+func GenerateChallengeToken(username string, userID int64) (string, error) {
+	jti, err := RandomString(16)
+	if err != nil {
+		return "", err
+	}
 	claims := Claims{
 		Username: username,
 		UserID:   userID,
+		Purpose:  challengePurpose,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ChallengeTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secret)
+	return activeKeys().sign(claims)
 }
 
-func ValidateToken(tokenStr string) (*Claims, error) {
+// ValidateChallengeToken parses a token from GenerateChallengeToken.
+func ValidateChallengeToken(tokenStr string) (*Claims, error) {
+	claims, err := parseToken(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != challengePurpose {
+		return nil, errors.New("not a challenge token")
+	}
+	return claims, nil
+}
+
+func parseToken(tokenStr string) (*Claims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-		return secret, nil
-	})
-	if err != nil || !token.Valid {
+	token, err := jwt.ParseWithClaims(tokenStr, claims, activeKeys().keyfunc)
+	if err != nil {
 		return nil, err
 	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
 	return claims, nil
 }
 
-func BlacklistToken(tokenStr string) {
-	blacklist.Store(tokenStr, time.Now())
-	go func() {
-		time.Sleep(blacklistTTL)
-		blacklist.Delete(tokenStr)
-	}()
+// GenerateRefreshToken returns a new opaque refresh token. Only its hash
+// (see HashRefreshToken) should ever be persisted.
+func GenerateRefreshToken() (string, error) {
+	return RandomString(32)
+}
+
+// HashRefreshToken returns the hex encoded SHA-256 digest of a refresh token.
+func HashRefreshToken(token string) string {
+	return hashToken(token)
+}
+
+// HashPasswordResetToken returns the hex encoded SHA-256 digest of a password
+// reset token.
+func HashPasswordResetToken(token string) string {
+	return hashToken(token)
+}
+
+// GenerateVerificationCode returns a random numeric code of n digits, short
+// enough to be typed in by hand.
+func GenerateVerificationCode(n int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+	v, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", n, v), nil
+}
+
+// GenerateAPIKey returns a new API key and its prefix. Keys look like
+// "gek_<prefix>.<secret>"; the prefix is stored in clear for lookup while the
+// full key is only stored hashed (see HashAPIKey).
+func GenerateAPIKey() (key, prefix string, err error) {
+	prefixBytes := make([]byte, 6)
+	if _, err := rand.Read(prefixBytes); err != nil {
+		return "", "", err
+	}
+	prefix = hex.EncodeToString(prefixBytes)
+	secret, err := RandomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	return apiKeyScheme + prefix + "." + secret, prefix, nil
+}
+
+// ParseAPIKey returns the lookup prefix of a key in the GenerateAPIKey format.
+func ParseAPIKey(key string) (prefix string, ok bool) {
+	rest, ok := strings.CutPrefix(key, apiKeyScheme)
+	if !ok {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, ".")
+	if !ok || prefix == "" || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+// HashAPIKey returns the hex encoded SHA-256 digest of an API key.
+func HashAPIKey(key string) string {
+	return hashToken(key)
+}
+
+// RandomString returns n bytes from crypto/rand encoded as unpadded base64url.
+func RandomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
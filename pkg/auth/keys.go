@@ -0,0 +1,264 @@
+// pkg/auth/keys.go
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Key is a single JWT key identified by its kid. Keys without a signing part
+// can only be used to verify tokens signed before a rotation.
+type Key struct {
+	ID        string
+	Algorithm string
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+func (k *Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// Public returns the public key of an asymmetric key, or nil for HMAC keys.
+func (k *Key) Public() crypto.PublicKey {
+	switch pub := k.verifyKey.(type) {
+	case *rsa.PublicKey, ed25519.PublicKey:
+		return pub
+	}
+	return nil
+}
+
+// NewHMACKey returns an HS256 key. If kid is empty it is derived from the secret.
+func NewHMACKey(kid string, secret []byte) (*Key, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("HMAC secret must be at least 32 bytes")
+	}
+	if kid == "" {
+		kid = thumbprint(secret)
+	}
+	return &Key{ID: kid, Algorithm: AlgHS256, signKey: secret, verifyKey: secret}, nil
+}
+
+// ParsePrivateKeyPEM parses an RSA (PKCS#1 or PKCS#8) or Ed25519 (PKCS#8)
+// private key. If kid is empty it is derived from the public key.
+func ParsePrivateKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch priv := parsed.(type) {
+	case *rsa.PrivateKey:
+		return newAsymmetricKey(kid, AlgRS256, priv, &priv.PublicKey)
+	case ed25519.PrivateKey:
+		return newAsymmetricKey(kid, AlgEdDSA, priv, priv.Public())
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", parsed)
+}
+
+// ParsePublicKeyPEM parses an RSA or Ed25519 public key (PKIX) into a
+// verification-only key.
+func ParsePublicKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if block.Type != "PUBLIC KEY" {
+		// Accept a private key file too, but only keep its public half
+		key, err := ParsePrivateKeyPEM(kid, data)
+		if err != nil {
+			return nil, err
+		}
+		key.signKey = nil
+		return key, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch pub := parsed.(type) {
+	case *rsa.PublicKey:
+		return newAsymmetricKey(kid, AlgRS256, nil, pub)
+	case ed25519.PublicKey:
+		return newAsymmetricKey(kid, AlgEdDSA, nil, pub)
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", parsed)
+}
+
+func newAsymmetricKey(kid, alg string, priv, pub interface{}) (*Key, error) {
+	if kid == "" {
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		kid = thumbprint(der)
+	}
+	return &Key{ID: kid, Algorithm: alg, signKey: priv, verifyKey: pub}, nil
+}
+
+func thumbprint(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+// KeySet holds the key new tokens are signed with plus every key whose tokens
+// are still accepted. Rotating means adding a new signing key and keeping the
+// previous one as a verification key until its tokens have expired.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+func NewKeySet(signing *Key, verification ...*Key) (*KeySet, error) {
+	if signing == nil || signing.signKey == nil {
+		return nil, errors.New("signing key must include a private part")
+	}
+	ks := &KeySet{signing: signing, keys: map[string]*Key{signing.ID: signing}}
+	for _, k := range verification {
+		if _, dup := ks.keys[k.ID]; dup {
+			return nil, fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		ks.keys[k.ID] = k
+	}
+	return ks, nil
+}
+
+// SigningKey returns the key used for new tokens.
+func (ks *KeySet) SigningKey() *Key {
+	return ks.signing
+}
+
+// Keys returns all keys accepted for verification.
+func (ks *KeySet) Keys() []*Key {
+	keys := make([]*Key, 0, len(ks.keys))
+	for _, k := range ks.keys {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.method(), claims)
+	token.Header["kid"] = ks.signing.ID
+	return token.SignedString(ks.signing.signKey)
+}
+
+// keyfunc resolves the verification key from the kid header and refuses
+// tokens whose alg does not match that key, so an RSA public key can never be
+// abused as an HMAC secret.
+func (ks *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method %q", token.Method.Alg())
+	}
+	return key.verifyKey, nil
+}
+
+// NewEphemeralKeySet returns a key set with a random HMAC key. Tokens signed
+// with it do not survive a restart and are not accepted by other replicas.
+func NewEphemeralKeySet() *KeySet {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	key, _ := NewHMACKey("", secret)
+	ks, _ := NewKeySet(key)
+	return ks
+}
+
+// LoadKeySetFromEnv builds a key set from the environment:
+//
+//	JWT_SIGNING_KEY_FILE        PEM private key (RSA or Ed25519) used to sign tokens
+//	JWT_SECRET                  HMAC secret (HS256), used when no key file is set
+//	JWT_SIGNING_KEY_ID          kid of the signing key, derived from the key if empty
+//	JWT_VERIFICATION_KEY_FILES  comma separated kid=path list of previous PEM keys
+//	JWT_PREVIOUS_SECRETS        comma separated kid=secret list of previous HMAC secrets
+//
+// It returns nil and no error if no signing key is configured.
+func LoadKeySetFromEnv() (*KeySet, error) {
+	var signing *Key
+	var err error
+	kid := os.Getenv("JWT_SIGNING_KEY_ID")
+	if path := os.Getenv("JWT_SIGNING_KEY_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if signing, err = ParsePrivateKeyPEM(kid, data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	} else if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		if signing, err = NewHMACKey(kid, []byte(secret)); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, nil
+	}
+
+	var verification []*Key
+	for kid, path := range parsePairs(os.Getenv("JWT_VERIFICATION_KEY_FILES")) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePublicKeyPEM(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		verification = append(verification, key)
+	}
+	for kid, secret := range parsePairs(os.Getenv("JWT_PREVIOUS_SECRETS")) {
+		key, err := NewHMACKey(kid, []byte(secret))
+		if err != nil {
+			return nil, err
+		}
+		key.signKey = nil
+		verification = append(verification, key)
+	}
+	return NewKeySet(signing, verification...)
+}
+
+func parsePairs(s string) map[string]string {
+	pairs := make(map[string]string)
+	for _, item := range strings.Split(s, ",") {
+		kid, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if ok && kid != "" && value != "" {
+			pairs[kid] = value
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,115 @@
+// pkg/auth/keys_test.go
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func rsaPEM(t *testing.T) []byte {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)})
+}
+
+func ed25519PEM(t *testing.T) []byte {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	defer SetKeySet(activeKeys())
+
+	oldKey, err := ParsePrivateKeyPEM("old", rsaPEM(t))
+	if err != nil {
+		t.Fatalf("ParsePrivateKeyPEM() error = %v", err)
+	}
+	oldSet, _ := NewKeySet(oldKey)
+	SetKeySet(oldSet)
+	oldToken, err := GenerateToken("testuser@example.com", 1, []string{"merchant"}, "")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	newKey, err := ParsePrivateKeyPEM("new", ed25519PEM(t))
+	if err != nil {
+		t.Fatalf("ParsePrivateKeyPEM() error = %v", err)
+	}
+	if newKey.Algorithm != AlgEdDSA {
+		t.Errorf("Algorithm = %v, want %v", newKey.Algorithm, AlgEdDSA)
+	}
+	rotated, err := NewKeySet(newKey, oldKey)
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+	SetKeySet(rotated)
+
+	if _, err := ValidateToken(oldToken); err != nil {
+		t.Errorf("ValidateToken() old token after rotation error = %v", err)
+	}
+	newToken, _ := GenerateToken("testuser@example.com", 1, []string{"merchant"}, "")
+	parsed, _, _ := jwt.NewParser().ParseUnverified(newToken, &Claims{})
+	if parsed.Header["kid"] != "new" || parsed.Method.Alg() != AlgEdDSA {
+		t.Errorf("new token header = %v, want kid new and alg EdDSA", parsed.Header)
+	}
+
+	// Once the old key is retired its tokens are rejected
+	retired, _ := NewKeySet(newKey)
+	SetKeySet(retired)
+	if _, err := ValidateToken(oldToken); err == nil {
+		t.Errorf("ValidateToken() token of retired key, want error")
+	}
+}
+
+func TestKeySet_RejectsAlgorithmConfusion(t *testing.T) {
+	defer SetKeySet(activeKeys())
+
+	key, _ := ParsePrivateKeyPEM("rsa", rsaPEM(t))
+	ks, _ := NewKeySet(key)
+	SetKeySet(ks)
+
+	// An HS256 token keyed with the RSA public key must not verify
+	der, _ := x509.MarshalPKIXPublicKey(key.Public())
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{UserID: 1})
+	forged.Header["kid"] = "rsa"
+	signed, _ := forged.SignedString(der)
+	if _, err := ValidateToken(signed); err == nil {
+		t.Errorf("ValidateToken() forged HS256 token, want error")
+	}
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	rsaKey, _ := ParsePrivateKeyPEM("rsa", rsaPEM(t))
+	edKey, _ := ParsePrivateKeyPEM("ed", ed25519PEM(t))
+	hmacKey, _ := NewHMACKey("hmac", make([]byte, 32))
+	ks, err := NewKeySet(rsaKey, edKey, hmacKey)
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+
+	set := ks.JWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("JWKS() keys = %v, want the two public keys only", set.Keys)
+	}
+	if set.Keys[0].Kid != "ed" || set.Keys[0].Kty != "OKP" || set.Keys[0].X == "" {
+		t.Errorf("JWKS() Ed25519 key = %+v", set.Keys[0])
+	}
+	if set.Keys[1].Kid != "rsa" || set.Keys[1].Kty != "RSA" || set.Keys[1].N == "" || set.Keys[1].E != "AQAB" {
+		t.Errorf("JWKS() RSA key = %+v", set.Keys[1])
+	}
+}
@@ -0,0 +1,86 @@
+// pkg/auth/totp_test.go
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 test key of RFC 6238, "12345678901234567890".
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode_RFC6238(t *testing.T) {
+	// The RFC lists 8 digit codes; ours are their last 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(rfc6238Secret, time.Unix(tt.unix, 0))
+		if err != nil || got != tt.want {
+			t.Errorf("TOTPCode(%d) = %q, %v, want %q", tt.unix, got, err, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	code, _ := TOTPCode(rfc6238Secret, now)
+
+	step, ok := ValidateTOTP(rfc6238Secret, code, now)
+	if !ok || step != now.Unix()/totpPeriod {
+		t.Errorf("ValidateTOTP() = %d, %v, want step %d", step, ok, now.Unix()/totpPeriod)
+	}
+	if _, ok := ValidateTOTP(rfc6238Secret, code, now.Add(totpPeriod*time.Second)); !ok {
+		t.Errorf("ValidateTOTP() one period later = false, want true")
+	}
+	if _, ok := ValidateTOTP(rfc6238Secret, code, now.Add(3*totpPeriod*time.Second)); ok {
+		t.Errorf("ValidateTOTP() three periods later = true, want false")
+	}
+	if _, ok := ValidateTOTP(rfc6238Secret, "12345", now); ok {
+		t.Errorf("ValidateTOTP() short code = true, want false")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI("grpc-ecommerce", "user@example.com", rfc6238Secret)
+	want := "otpauth://totp/grpc-ecommerce:user@example.com?"
+	if !strings.HasPrefix(uri, want) || !strings.Contains(uri, "secret="+rfc6238Secret) {
+		t.Errorf("TOTPProvisioningURI() = %q, want prefix %q and the secret", uri, want)
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(2)
+	if err != nil || len(codes) != 2 || codes[0] == codes[1] {
+		t.Fatalf("GenerateRecoveryCodes() = %v, %v, want two distinct codes", codes, err)
+	}
+	loose := strings.ToUpper(strings.ReplaceAll(codes[0], "-", " "))
+	if HashRecoveryCode(loose) != HashRecoveryCode(codes[0]) {
+		t.Errorf("HashRecoveryCode(%q) differs from HashRecoveryCode(%q)", loose, codes[0])
+	}
+}
+
+func TestChallengeToken(t *testing.T) {
+	challenge, err := GenerateChallengeToken("user@example.com", 1)
+	if err != nil {
+		t.Fatalf("GenerateChallengeToken() error = %v", err)
+	}
+	if _, err := ValidateToken(challenge); err == nil {
+		t.Errorf("ValidateToken(challenge) error = nil, want error")
+	}
+	if claims, err := ValidateChallengeToken(challenge); err != nil || claims.UserID != 1 {
+		t.Errorf("ValidateChallengeToken() = %v, %v, want user 1", claims, err)
+	}
+
+	access, _ := GenerateToken("user@example.com", 1, nil, "")
+	if _, err := ValidateChallengeToken(access); err == nil {
+		t.Errorf("ValidateChallengeToken(access token) error = nil, want error")
+	}
+}